@@ -11,6 +11,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -88,6 +89,52 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{0}
 }
 
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_ASC  SortOrder = 0
+	SortOrder_SORT_ORDER_DESC SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_ASC",
+		1: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_ASC":  0,
+		"SORT_ORDER_DESC": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[1].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[1]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{1}
+}
+
 type VersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -267,7 +314,7 @@ type JobInstance struct {
 	State JobState `protobuf:"varint,11,opt,name=state,proto3,enum=job.v1.JobState" json:"state,omitempty"`
 	// Job arguments
 	Arguments []string `protobuf:"bytes,12,rep,name=arguments,proto3" json:"arguments,omitempty"`
-	// Execution results (if completed)
+	// Execution results encoded as JSON values (if completed)
 	Results []string `protobuf:"bytes,13,rep,name=results,proto3" json:"results,omitempty"`
 	// Error information (if terminated)
 	Error        *string                `protobuf:"bytes,14,opt,name=error,proto3,oneof" json:"error,omitempty"`
@@ -608,7 +655,15 @@ type Query struct {
 	// Filter by job instance UUID
 	Uuid *string `protobuf:"bytes,2,opt,name=uuid,proto3,oneof" json:"uuid,omitempty"`
 	// Filter by job state
-	State         *JobState `protobuf:"varint,3,opt,name=state,proto3,enum=job.v1.JobState,oneof" json:"state,omitempty"`
+	State *JobState `protobuf:"varint,3,opt,name=state,proto3,enum=job.v1.JobState,oneof" json:"state,omitempty"`
+	// Maximum number of results (unlimited if unset or zero)
+	Limit *int32 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Number of results to skip
+	Offset *int32 `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// Order of the results by their timestamps (ascending if unset)
+	Order *SortOrder `protobuf:"varint,6,opt,name=order,proto3,enum=job.v1.SortOrder,oneof" json:"order,omitempty"`
+	// Page token after which the results start, returned as next_page_token of the previous page
+	PageToken     *string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -664,6 +719,34 @@ func (x *Query) GetState() JobState {
 	return JobState_JOB_STATE_UNSET
 }
 
+func (x *Query) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *Query) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *Query) GetOrder() SortOrder {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return SortOrder_SORT_ORDER_ASC
+}
+
+func (x *Query) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
 type LookupInstancesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Lookup query
@@ -712,7 +795,9 @@ func (x *LookupInstancesRequest) GetQuery() *Query {
 type LookupInstancesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of job instances
-	Instances     []*JobInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	Instances []*JobInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	// Page token of the next page, set if the query has a limit and more instances may follow
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -754,6 +839,13 @@ func (x *LookupInstancesResponse) GetInstances() []*JobInstance {
 	return nil
 }
 
+func (x *LookupInstancesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CancelInstancesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Lookup query
@@ -844,78 +936,1183 @@ func (x *CancelInstancesResponse) GetInstances() []*JobInstance {
 	return nil
 }
 
-var File_service_proto protoreflect.FileDescriptor
+type WaitInstanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the job instance to wait for
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_service_proto_rawDesc = "" +
-	"\n" +
-	"\rservice.proto\x12\x06job.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
-	"\x0eVersionRequest\"L\n" +
-	"\x0fVersionResponse\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
-	"\vapi_version\x18\x02 \x01(\tR\n" +
-	"apiVersion\"\xfe\x01\n" +
-	"\x03Job\x12\x12\n" +
-	"\x04kind\x18\x01 \x01(\tR\x04kind\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12?\n" +
-	"\rregistered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x12 \n" +
-	"\tcron_spec\x18\v \x01(\tH\x00R\bcronSpec\x88\x01\x01\x12@\n" +
-	"\vschedule_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
-	"scheduleAt\x88\x01\x01B\f\n" +
-	"\n" +
-	"_cron_specB\x0e\n" +
-	"\f_schedule_at\"\xb4\x06\n" +
-	"\vJobInstance\x12\x12\n" +
-	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
-	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12&\n" +
-	"\x05state\x18\v \x01(\x0e2\x10.job.v1.JobStateR\x05state\x12\x1c\n" +
-	"\targuments\x18\f \x03(\tR\targuments\x12\x18\n" +
-	"\aresults\x18\r \x03(\tR\aresults\x12\x19\n" +
-	"\x05error\x18\x0e \x01(\tH\x00R\x05error\x88\x01\x01\x12>\n" +
-	"\n" +
-	"created_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tcreatedAt\x88\x01\x01\x12B\n" +
-	"\fscheduled_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vscheduledAt\x88\x01\x01\x12B\n" +
-	"\fprocessed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vprocessedAt\x88\x01\x01\x12B\n" +
-	"\fcompleted_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\vcompletedAt\x88\x01\x01\x12D\n" +
-	"\rterminated_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\fterminatedAt\x88\x01\x01\x12@\n" +
-	"\vcanceled_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
-	"canceledAt\x88\x01\x01\x12A\n" +
-	"\ftimed_out_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampH\aR\n" +
-	"timedOutAt\x88\x01\x01\x12\x1f\n" +
-	"\battempts\x18\x1f \x01(\x05H\bR\battempts\x88\x01\x01B\b\n" +
-	"\x06_errorB\r\n" +
-	"\v_created_atB\x0f\n" +
-	"\r_scheduled_atB\x0f\n" +
-	"\r_processed_atB\x0f\n" +
-	"\r_completed_atB\x10\n" +
-	"\x0e_terminated_atB\x0e\n" +
-	"\f_canceled_atB\x0f\n" +
-	"\r_timed_out_atB\v\n" +
-	"\t_attempts\"t\n" +
-	"\x12ScheduleJobRequest\x12\x12\n" +
-	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
-	"\targuments\x18\v \x03(\tR\targuments\x12\x1f\n" +
-	"\bpriority\x18\f \x01(\x05H\x00R\bpriority\x88\x01\x01B\v\n" +
-	"\t_priority\"F\n" +
-	"\x13ScheduleJobResponse\x12/\n" +
-	"\binstance\x18\x01 \x01(\v2\x13.job.v1.JobInstanceR\binstance\"\x1b\n" +
-	"\x19ListRegisteredJobsRequest\"=\n" +
-	"\x1aListRegisteredJobsResponse\x12\x1f\n" +
-	"\x04jobs\x18\x01 \x03(\v2\v.job.v1.JobR\x04jobs\"\x82\x01\n" +
-	"\x05Query\x12\x17\n" +
-	"\x04kind\x18\x01 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
-	"\x04uuid\x18\x02 \x01(\tH\x01R\x04uuid\x88\x01\x01\x12+\n" +
-	"\x05state\x18\x03 \x01(\x0e2\x10.job.v1.JobStateH\x02R\x05state\x88\x01\x01B\a\n" +
-	"\x05_kindB\a\n" +
-	"\x05_uuidB\b\n" +
-	"\x06_state\"=\n" +
-	"\x16LookupInstancesRequest\x12#\n" +
-	"\x05query\x18\x01 \x01(\v2\r.job.v1.QueryR\x05query\"L\n" +
-	"\x17LookupInstancesResponse\x121\n" +
-	"\tinstances\x18\x01 \x03(\v2\x13.job.v1.JobInstanceR\tinstances\"=\n" +
-	"\x16CancelInstancesRequest\x12#\n" +
-	"\x05query\x18\x01 \x01(\v2\r.job.v1.QueryR\x05query\"L\n" +
-	"\x17CancelInstancesResponse\x121\n" +
-	"\tinstances\x18\x01 \x03(\v2\x13.job.v1.JobInstanceR\tinstances*\xce\x01\n" +
+func (x *WaitInstanceRequest) Reset() {
+	*x = WaitInstanceRequest{}
+	mi := &file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitInstanceRequest) ProtoMessage() {}
+
+func (x *WaitInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitInstanceRequest.ProtoReflect.Descriptor instead.
+func (*WaitInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WaitInstanceRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type WaitInstanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job instance in a final state
+	Instance      *JobInstance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitInstanceResponse) Reset() {
+	*x = WaitInstanceResponse{}
+	mi := &file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitInstanceResponse) ProtoMessage() {}
+
+func (x *WaitInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitInstanceResponse.ProtoReflect.Descriptor instead.
+func (*WaitInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WaitInstanceResponse) GetInstance() *JobInstance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type AuditRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp of the operation
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Actor who made the operation
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Operation (e.g., "schedule_job", "cancel_instances")
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Job kind of the operation, if any
+	Kind *string `protobuf:"bytes,4,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	// Query or filter of the operation, if any
+	Query *string `protobuf:"bytes,5,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	// UUIDs of the affected job instances
+	Uuids []string `protobuf:"bytes,6,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Unique identifier of the audit record
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditRecord) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *AuditRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type LookupAuditRecordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lookup query
+	Query         *Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupAuditRecordsRequest) Reset() {
+	*x = LookupAuditRecordsRequest{}
+	mi := &file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupAuditRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupAuditRecordsRequest) ProtoMessage() {}
+
+func (x *LookupAuditRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupAuditRecordsRequest.ProtoReflect.Descriptor instead.
+func (*LookupAuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LookupAuditRecordsRequest) GetQuery() *Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type LookupAuditRecordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of audit records
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// Page token of the next page, set if the query has a limit and more records may follow
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupAuditRecordsResponse) Reset() {
+	*x = LookupAuditRecordsResponse{}
+	mi := &file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupAuditRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupAuditRecordsResponse) ProtoMessage() {}
+
+func (x *LookupAuditRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupAuditRecordsResponse.ProtoReflect.Descriptor instead.
+func (*LookupAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LookupAuditRecordsResponse) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *LookupAuditRecordsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Subscription identifier (UUID), set by the server when added
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Webhook endpoint URL
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Glob pattern of job kinds to notify (e.g., "billing.*"); all kinds if unset
+	Kind *string `protobuf:"bytes,3,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	// Job states to notify; all states if empty
+	States []JobState `protobuf:"varint,4,rep,packed,name=states,proto3,enum=job.v1.JobState" json:"states,omitempty"`
+	// Secret key to sign the notification payloads with HMAC-SHA256; never returned by the server
+	Secret *string `protobuf:"bytes,5,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	// Creation timestamp
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Subscription) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *Subscription) GetStates() []JobState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *Subscription) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Subscription to add
+	Subscription  *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubscriptionRequest) Reset() {
+	*x = AddSubscriptionRequest{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubscriptionRequest) ProtoMessage() {}
+
+func (x *AddSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*AddSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddSubscriptionRequest) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type AddSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Added subscription including its identifier
+	Subscription  *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubscriptionResponse) Reset() {
+	*x = AddSubscriptionResponse{}
+	mi := &file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubscriptionResponse) ProtoMessage() {}
+
+func (x *AddSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*AddSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type RemoveSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier (UUID) of the subscription to remove
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSubscriptionRequest) Reset() {
+	*x = RemoveSubscriptionRequest{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubscriptionRequest) ProtoMessage() {}
+
+func (x *RemoveSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSubscriptionResponse) Reset() {
+	*x = RemoveSubscriptionResponse{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubscriptionResponse) ProtoMessage() {}
+
+func (x *RemoveSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+type ListSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of subscriptions
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type StateCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job state
+	State JobState `protobuf:"varint,1,opt,name=state,proto3,enum=job.v1.JobState" json:"state,omitempty"`
+	// Number of job instances in the state
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateCount) Reset() {
+	*x = StateCount{}
+	mi := &file_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateCount) ProtoMessage() {}
+
+func (x *StateCount) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateCount.ProtoReflect.Descriptor instead.
+func (*StateCount) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StateCount) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSET
+}
+
+func (x *StateCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type InstanceStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Numbers of job instances by their current states, which changed within the window, are queued, or are processing
+	States []*StateCount `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	// Number of job instances waiting in the queue
+	QueueDepth int64 `protobuf:"varint,2,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// Scheduled time of the oldest job instance waiting in the queue; unset if the queue is empty
+	OldestScheduledAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=oldest_scheduled_at,json=oldestScheduledAt,proto3,oneof" json:"oldest_scheduled_at,omitempty"`
+	// Number of executions completed within the window
+	Completed int64 `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Number of executions terminated or timed out within the window
+	Failed int64 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Ratio of the completed executions to the completed and failed executions within the window
+	SuccessRate float64 `protobuf:"fixed64,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// Ratio of the failed executions to the completed and failed executions within the window
+	FailureRate   float64 `protobuf:"fixed64,7,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceStats) Reset() {
+	*x = InstanceStats{}
+	mi := &file_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStats) ProtoMessage() {}
+
+func (x *InstanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStats.ProtoReflect.Descriptor instead.
+func (*InstanceStats) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InstanceStats) GetStates() []*StateCount {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetQueueDepth() int64 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetOldestScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestScheduledAt
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+type KindStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job kind
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Statistics of the job instances of the kind
+	Stats         *InstanceStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindStats) Reset() {
+	*x = KindStats{}
+	mi := &file_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindStats) ProtoMessage() {}
+
+func (x *KindStats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindStats.ProtoReflect.Descriptor instead.
+func (*KindStats) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *KindStats) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KindStats) GetStats() *InstanceStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Stats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time when the statistics were collected
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Window in which the completed and failed executions are counted
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Statistics of the job instances of all kinds
+	Total *InstanceStats `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	// Statistics by job kind
+	Kinds []*KindStats `protobuf:"bytes,4,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// Number of workers
+	Workers int32 `protobuf:"varint,5,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Number of workers processing job instances
+	BusyWorkers int32 `protobuf:"varint,6,opt,name=busy_workers,json=busyWorkers,proto3" json:"busy_workers,omitempty"`
+	// Ratio of the busy workers to all workers
+	WorkerUtilization float64 `protobuf:"fixed64,7,opt,name=worker_utilization,json=workerUtilization,proto3" json:"worker_utilization,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Stats) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Stats) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Stats) GetTotal() *InstanceStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Stats) GetKinds() []*KindStats {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *Stats) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Stats) GetBusyWorkers() int32 {
+	if x != nil {
+		return x.BusyWorkers
+	}
+	return 0
+}
+
+func (x *Stats) GetWorkerUtilization() float64 {
+	if x != nil {
+		return x.WorkerUtilization
+	}
+	return 0
+}
+
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Window in which the completed and failed executions are counted; the server default (1 hour) if unset
+	Window        *durationpb.Duration `protobuf:"bytes,1,opt,name=window,proto3,oneof" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStatsRequest) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type GetStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Aggregate statistics
+	Stats         *Stats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStatsResponse) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+var File_service_proto protoreflect.FileDescriptor
+
+const file_service_proto_rawDesc = "" +
+	"\n" +
+	"\rservice.proto\x12\x06job.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
+	"\x0eVersionRequest\"L\n" +
+	"\x0fVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
+	"\vapi_version\x18\x02 \x01(\tR\n" +
+	"apiVersion\"\xfe\x01\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12?\n" +
+	"\rregistered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x12 \n" +
+	"\tcron_spec\x18\v \x01(\tH\x00R\bcronSpec\x88\x01\x01\x12@\n" +
+	"\vschedule_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"scheduleAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_cron_specB\x0e\n" +
+	"\f_schedule_at\"\xb4\x06\n" +
+	"\vJobInstance\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12&\n" +
+	"\x05state\x18\v \x01(\x0e2\x10.job.v1.JobStateR\x05state\x12\x1c\n" +
+	"\targuments\x18\f \x03(\tR\targuments\x12\x18\n" +
+	"\aresults\x18\r \x03(\tR\aresults\x12\x19\n" +
+	"\x05error\x18\x0e \x01(\tH\x00R\x05error\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tcreatedAt\x88\x01\x01\x12B\n" +
+	"\fscheduled_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vscheduledAt\x88\x01\x01\x12B\n" +
+	"\fprocessed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vprocessedAt\x88\x01\x01\x12B\n" +
+	"\fcompleted_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\vcompletedAt\x88\x01\x01\x12D\n" +
+	"\rterminated_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\fterminatedAt\x88\x01\x01\x12@\n" +
+	"\vcanceled_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
+	"canceledAt\x88\x01\x01\x12A\n" +
+	"\ftimed_out_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampH\aR\n" +
+	"timedOutAt\x88\x01\x01\x12\x1f\n" +
+	"\battempts\x18\x1f \x01(\x05H\bR\battempts\x88\x01\x01B\b\n" +
+	"\x06_errorB\r\n" +
+	"\v_created_atB\x0f\n" +
+	"\r_scheduled_atB\x0f\n" +
+	"\r_processed_atB\x0f\n" +
+	"\r_completed_atB\x10\n" +
+	"\x0e_terminated_atB\x0e\n" +
+	"\f_canceled_atB\x0f\n" +
+	"\r_timed_out_atB\v\n" +
+	"\t_attempts\"t\n" +
+	"\x12ScheduleJobRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
+	"\targuments\x18\v \x03(\tR\targuments\x12\x1f\n" +
+	"\bpriority\x18\f \x01(\x05H\x00R\bpriority\x88\x01\x01B\v\n" +
+	"\t_priority\"F\n" +
+	"\x13ScheduleJobResponse\x12/\n" +
+	"\binstance\x18\x01 \x01(\v2\x13.job.v1.JobInstanceR\binstance\"\x1b\n" +
+	"\x19ListRegisteredJobsRequest\"=\n" +
+	"\x1aListRegisteredJobsResponse\x12\x1f\n" +
+	"\x04jobs\x18\x01 \x03(\v2\v.job.v1.JobR\x04jobs\"\xba\x02\n" +
+	"\x05Query\x12\x17\n" +
+	"\x04kind\x18\x01 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x17\n" +
+	"\x04uuid\x18\x02 \x01(\tH\x01R\x04uuid\x88\x01\x01\x12+\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x10.job.v1.JobStateH\x02R\x05state\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x05 \x01(\x05H\x04R\x06offset\x88\x01\x01\x12,\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x11.job.v1.SortOrderH\x05R\x05order\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tH\x06R\tpageToken\x88\x01\x01B\a\n" +
+	"\x05_kindB\a\n" +
+	"\x05_uuidB\b\n" +
+	"\x06_stateB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_orderB\r\n" +
+	"\v_page_token\"=\n" +
+	"\x16LookupInstancesRequest\x12#\n" +
+	"\x05query\x18\x01 \x01(\v2\r.job.v1.QueryR\x05query\"t\n" +
+	"\x17LookupInstancesResponse\x121\n" +
+	"\tinstances\x18\x01 \x03(\v2\x13.job.v1.JobInstanceR\tinstances\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"=\n" +
+	"\x16CancelInstancesRequest\x12#\n" +
+	"\x05query\x18\x01 \x01(\v2\r.job.v1.QueryR\x05query\"L\n" +
+	"\x17CancelInstancesResponse\x121\n" +
+	"\tinstances\x18\x01 \x03(\v2\x13.job.v1.JobInstanceR\tinstances\")\n" +
+	"\x13WaitInstanceRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"G\n" +
+	"\x14WaitInstanceResponse\x12/\n" +
+	"\binstance\x18\x01 \x01(\v2\x13.job.v1.JobInstanceR\binstance\"\xe8\x01\n" +
+	"\vAuditRecord\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x17\n" +
+	"\x04kind\x18\x04 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x19\n" +
+	"\x05query\x18\x05 \x01(\tH\x01R\x05query\x88\x01\x01\x12\x14\n" +
+	"\x05uuids\x18\x06 \x03(\tR\x05uuids\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02idB\a\n" +
+	"\x05_kindB\b\n" +
+	"\x06_query\"@\n" +
+	"\x19LookupAuditRecordsRequest\x12#\n" +
+	"\x05query\x18\x01 \x01(\v2\r.job.v1.QueryR\x05query\"s\n" +
+	"\x1aLookupAuditRecordsResponse\x12-\n" +
+	"\arecords\x18\x01 \x03(\v2\x13.job.v1.AuditRecordR\arecords\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdf\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x17\n" +
+	"\x04kind\x18\x03 \x01(\tH\x00R\x04kind\x88\x01\x01\x12(\n" +
+	"\x06states\x18\x04 \x03(\x0e2\x10.job.v1.JobStateR\x06states\x12\x1b\n" +
+	"\x06secret\x18\x05 \x01(\tH\x01R\x06secret\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_kindB\t\n" +
+	"\a_secret\"R\n" +
+	"\x16AddSubscriptionRequest\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.job.v1.SubscriptionR\fsubscription\"S\n" +
+	"\x17AddSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.job.v1.SubscriptionR\fsubscription\"+\n" +
+	"\x19RemoveSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aRemoveSubscriptionResponse\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"W\n" +
+	"\x19ListSubscriptionsResponse\x12:\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x14.job.v1.SubscriptionR\rsubscriptions\"J\n" +
+	"\n" +
+	"StateCount\x12&\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x10.job.v1.JobStateR\x05state\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xc1\x02\n" +
+	"\rInstanceStats\x12*\n" +
+	"\x06states\x18\x01 \x03(\v2\x12.job.v1.StateCountR\x06states\x12\x1f\n" +
+	"\vqueue_depth\x18\x02 \x01(\x03R\n" +
+	"queueDepth\x12O\n" +
+	"\x13oldest_scheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x11oldestScheduledAt\x88\x01\x01\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x03R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x03R\x06failed\x12!\n" +
+	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\x12!\n" +
+	"\ffailure_rate\x18\a \x01(\x01R\vfailureRateB\x16\n" +
+	"\x14_oldest_scheduled_at\"L\n" +
+	"\tKindStats\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12+\n" +
+	"\x05stats\x18\x02 \x01(\v2\x15.job.v1.InstanceStatsR\x05stats\"\xb6\x02\n" +
+	"\x05Stats\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12+\n" +
+	"\x05total\x18\x03 \x01(\v2\x15.job.v1.InstanceStatsR\x05total\x12'\n" +
+	"\x05kinds\x18\x04 \x03(\v2\x11.job.v1.KindStatsR\x05kinds\x12\x18\n" +
+	"\aworkers\x18\x05 \x01(\x05R\aworkers\x12!\n" +
+	"\fbusy_workers\x18\x06 \x01(\x05R\vbusyWorkers\x12-\n" +
+	"\x12worker_utilization\x18\a \x01(\x01R\x11workerUtilization\"T\n" +
+	"\x0fGetStatsRequest\x126\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationH\x00R\x06window\x88\x01\x01B\t\n" +
+	"\a_window\"7\n" +
+	"\x10GetStatsResponse\x12#\n" +
+	"\x05stats\x18\x01 \x01(\v2\r.job.v1.StatsR\x05stats*\xce\x01\n" +
 	"\bJobState\x12\x13\n" +
 	"\x0fJOB_STATE_UNSET\x10\x00\x12\x15\n" +
 	"\x11JOB_STATE_CREATED\x10\x01\x12\x17\n" +
@@ -924,7 +2121,10 @@ const file_service_proto_rawDesc = "" +
 	"\x13JOB_STATE_CANCELLED\x10\b\x12\x17\n" +
 	"\x13JOB_STATE_TIMED_OUT\x10\x10\x12\x17\n" +
 	"\x13JOB_STATE_COMPLETED\x10 \x12\x18\n" +
-	"\x14JOB_STATE_TERMINATED\x10@2\x98\x03\n" +
+	"\x14JOB_STATE_TERMINATED\x10@*4\n" +
+	"\tSortOrder\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x00\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x012\x8a\a\n" +
 	"\n" +
 	"JobService\x12=\n" +
 	"\n" +
@@ -932,7 +2132,13 @@ const file_service_proto_rawDesc = "" +
 	"\vScheduleJob\x12\x1a.job.v1.ScheduleJobRequest\x1a\x1b.job.v1.ScheduleJobResponse\x12[\n" +
 	"\x12ListRegisteredJobs\x12!.job.v1.ListRegisteredJobsRequest\x1a\".job.v1.ListRegisteredJobsResponse\x12R\n" +
 	"\x0fLookupInstances\x12\x1e.job.v1.LookupInstancesRequest\x1a\x1f.job.v1.LookupInstancesResponse\x12R\n" +
-	"\x0fCancelInstances\x12\x1e.job.v1.CancelInstancesRequest\x1a\x1f.job.v1.CancelInstancesResponseB*Z(github.com/cybergarage/go-job/api/job/v1b\x06proto3"
+	"\x0fCancelInstances\x12\x1e.job.v1.CancelInstancesRequest\x1a\x1f.job.v1.CancelInstancesResponse\x12I\n" +
+	"\fWaitInstance\x12\x1b.job.v1.WaitInstanceRequest\x1a\x1c.job.v1.WaitInstanceResponse\x12[\n" +
+	"\x12LookupAuditRecords\x12!.job.v1.LookupAuditRecordsRequest\x1a\".job.v1.LookupAuditRecordsResponse\x12R\n" +
+	"\x0fAddSubscription\x12\x1e.job.v1.AddSubscriptionRequest\x1a\x1f.job.v1.AddSubscriptionResponse\x12[\n" +
+	"\x12RemoveSubscription\x12!.job.v1.RemoveSubscriptionRequest\x1a\".job.v1.RemoveSubscriptionResponse\x12X\n" +
+	"\x11ListSubscriptions\x12 .job.v1.ListSubscriptionsRequest\x1a!.job.v1.ListSubscriptionsResponse\x12=\n" +
+	"\bGetStats\x12\x17.job.v1.GetStatsRequest\x1a\x18.job.v1.GetStatsResponseB*Z(github.com/cybergarage/go-job/api/job/v1b\x06proto3"
 
 var (
 	file_service_proto_rawDescOnce sync.Once
@@ -946,58 +2152,110 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_service_proto_goTypes = []any{
 	(JobState)(0),                      // 0: job.v1.JobState
-	(*VersionRequest)(nil),             // 1: job.v1.VersionRequest
-	(*VersionResponse)(nil),            // 2: job.v1.VersionResponse
-	(*Job)(nil),                        // 3: job.v1.Job
-	(*JobInstance)(nil),                // 4: job.v1.JobInstance
-	(*ScheduleJobRequest)(nil),         // 5: job.v1.ScheduleJobRequest
-	(*ScheduleJobResponse)(nil),        // 6: job.v1.ScheduleJobResponse
-	(*ListRegisteredJobsRequest)(nil),  // 7: job.v1.ListRegisteredJobsRequest
-	(*ListRegisteredJobsResponse)(nil), // 8: job.v1.ListRegisteredJobsResponse
-	(*Query)(nil),                      // 9: job.v1.Query
-	(*LookupInstancesRequest)(nil),     // 10: job.v1.LookupInstancesRequest
-	(*LookupInstancesResponse)(nil),    // 11: job.v1.LookupInstancesResponse
-	(*CancelInstancesRequest)(nil),     // 12: job.v1.CancelInstancesRequest
-	(*CancelInstancesResponse)(nil),    // 13: job.v1.CancelInstancesResponse
-	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
+	(SortOrder)(0),                     // 1: job.v1.SortOrder
+	(*VersionRequest)(nil),             // 2: job.v1.VersionRequest
+	(*VersionResponse)(nil),            // 3: job.v1.VersionResponse
+	(*Job)(nil),                        // 4: job.v1.Job
+	(*JobInstance)(nil),                // 5: job.v1.JobInstance
+	(*ScheduleJobRequest)(nil),         // 6: job.v1.ScheduleJobRequest
+	(*ScheduleJobResponse)(nil),        // 7: job.v1.ScheduleJobResponse
+	(*ListRegisteredJobsRequest)(nil),  // 8: job.v1.ListRegisteredJobsRequest
+	(*ListRegisteredJobsResponse)(nil), // 9: job.v1.ListRegisteredJobsResponse
+	(*Query)(nil),                      // 10: job.v1.Query
+	(*LookupInstancesRequest)(nil),     // 11: job.v1.LookupInstancesRequest
+	(*LookupInstancesResponse)(nil),    // 12: job.v1.LookupInstancesResponse
+	(*CancelInstancesRequest)(nil),     // 13: job.v1.CancelInstancesRequest
+	(*CancelInstancesResponse)(nil),    // 14: job.v1.CancelInstancesResponse
+	(*WaitInstanceRequest)(nil),        // 15: job.v1.WaitInstanceRequest
+	(*WaitInstanceResponse)(nil),       // 16: job.v1.WaitInstanceResponse
+	(*AuditRecord)(nil),                // 17: job.v1.AuditRecord
+	(*LookupAuditRecordsRequest)(nil),  // 18: job.v1.LookupAuditRecordsRequest
+	(*LookupAuditRecordsResponse)(nil), // 19: job.v1.LookupAuditRecordsResponse
+	(*Subscription)(nil),               // 20: job.v1.Subscription
+	(*AddSubscriptionRequest)(nil),     // 21: job.v1.AddSubscriptionRequest
+	(*AddSubscriptionResponse)(nil),    // 22: job.v1.AddSubscriptionResponse
+	(*RemoveSubscriptionRequest)(nil),  // 23: job.v1.RemoveSubscriptionRequest
+	(*RemoveSubscriptionResponse)(nil), // 24: job.v1.RemoveSubscriptionResponse
+	(*ListSubscriptionsRequest)(nil),   // 25: job.v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),  // 26: job.v1.ListSubscriptionsResponse
+	(*StateCount)(nil),                 // 27: job.v1.StateCount
+	(*InstanceStats)(nil),              // 28: job.v1.InstanceStats
+	(*KindStats)(nil),                  // 29: job.v1.KindStats
+	(*Stats)(nil),                      // 30: job.v1.Stats
+	(*GetStatsRequest)(nil),            // 31: job.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),           // 32: job.v1.GetStatsResponse
+	(*timestamppb.Timestamp)(nil),      // 33: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 34: google.protobuf.Duration
 }
 var file_service_proto_depIdxs = []int32{
-	14, // 0: job.v1.Job.registered_at:type_name -> google.protobuf.Timestamp
-	14, // 1: job.v1.Job.schedule_at:type_name -> google.protobuf.Timestamp
+	33, // 0: job.v1.Job.registered_at:type_name -> google.protobuf.Timestamp
+	33, // 1: job.v1.Job.schedule_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: job.v1.JobInstance.state:type_name -> job.v1.JobState
-	14, // 3: job.v1.JobInstance.created_at:type_name -> google.protobuf.Timestamp
-	14, // 4: job.v1.JobInstance.scheduled_at:type_name -> google.protobuf.Timestamp
-	14, // 5: job.v1.JobInstance.processed_at:type_name -> google.protobuf.Timestamp
-	14, // 6: job.v1.JobInstance.completed_at:type_name -> google.protobuf.Timestamp
-	14, // 7: job.v1.JobInstance.terminated_at:type_name -> google.protobuf.Timestamp
-	14, // 8: job.v1.JobInstance.canceled_at:type_name -> google.protobuf.Timestamp
-	14, // 9: job.v1.JobInstance.timed_out_at:type_name -> google.protobuf.Timestamp
-	4,  // 10: job.v1.ScheduleJobResponse.instance:type_name -> job.v1.JobInstance
-	3,  // 11: job.v1.ListRegisteredJobsResponse.jobs:type_name -> job.v1.Job
+	33, // 3: job.v1.JobInstance.created_at:type_name -> google.protobuf.Timestamp
+	33, // 4: job.v1.JobInstance.scheduled_at:type_name -> google.protobuf.Timestamp
+	33, // 5: job.v1.JobInstance.processed_at:type_name -> google.protobuf.Timestamp
+	33, // 6: job.v1.JobInstance.completed_at:type_name -> google.protobuf.Timestamp
+	33, // 7: job.v1.JobInstance.terminated_at:type_name -> google.protobuf.Timestamp
+	33, // 8: job.v1.JobInstance.canceled_at:type_name -> google.protobuf.Timestamp
+	33, // 9: job.v1.JobInstance.timed_out_at:type_name -> google.protobuf.Timestamp
+	5,  // 10: job.v1.ScheduleJobResponse.instance:type_name -> job.v1.JobInstance
+	4,  // 11: job.v1.ListRegisteredJobsResponse.jobs:type_name -> job.v1.Job
 	0,  // 12: job.v1.Query.state:type_name -> job.v1.JobState
-	9,  // 13: job.v1.LookupInstancesRequest.query:type_name -> job.v1.Query
-	4,  // 14: job.v1.LookupInstancesResponse.instances:type_name -> job.v1.JobInstance
-	9,  // 15: job.v1.CancelInstancesRequest.query:type_name -> job.v1.Query
-	4,  // 16: job.v1.CancelInstancesResponse.instances:type_name -> job.v1.JobInstance
-	1,  // 17: job.v1.JobService.GetVersion:input_type -> job.v1.VersionRequest
-	5,  // 18: job.v1.JobService.ScheduleJob:input_type -> job.v1.ScheduleJobRequest
-	7,  // 19: job.v1.JobService.ListRegisteredJobs:input_type -> job.v1.ListRegisteredJobsRequest
-	10, // 20: job.v1.JobService.LookupInstances:input_type -> job.v1.LookupInstancesRequest
-	12, // 21: job.v1.JobService.CancelInstances:input_type -> job.v1.CancelInstancesRequest
-	2,  // 22: job.v1.JobService.GetVersion:output_type -> job.v1.VersionResponse
-	6,  // 23: job.v1.JobService.ScheduleJob:output_type -> job.v1.ScheduleJobResponse
-	8,  // 24: job.v1.JobService.ListRegisteredJobs:output_type -> job.v1.ListRegisteredJobsResponse
-	11, // 25: job.v1.JobService.LookupInstances:output_type -> job.v1.LookupInstancesResponse
-	13, // 26: job.v1.JobService.CancelInstances:output_type -> job.v1.CancelInstancesResponse
-	22, // [22:27] is the sub-list for method output_type
-	17, // [17:22] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	1,  // 13: job.v1.Query.order:type_name -> job.v1.SortOrder
+	10, // 14: job.v1.LookupInstancesRequest.query:type_name -> job.v1.Query
+	5,  // 15: job.v1.LookupInstancesResponse.instances:type_name -> job.v1.JobInstance
+	10, // 16: job.v1.CancelInstancesRequest.query:type_name -> job.v1.Query
+	5,  // 17: job.v1.CancelInstancesResponse.instances:type_name -> job.v1.JobInstance
+	5,  // 18: job.v1.WaitInstanceResponse.instance:type_name -> job.v1.JobInstance
+	33, // 19: job.v1.AuditRecord.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 20: job.v1.LookupAuditRecordsRequest.query:type_name -> job.v1.Query
+	17, // 21: job.v1.LookupAuditRecordsResponse.records:type_name -> job.v1.AuditRecord
+	0,  // 22: job.v1.Subscription.states:type_name -> job.v1.JobState
+	33, // 23: job.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	20, // 24: job.v1.AddSubscriptionRequest.subscription:type_name -> job.v1.Subscription
+	20, // 25: job.v1.AddSubscriptionResponse.subscription:type_name -> job.v1.Subscription
+	20, // 26: job.v1.ListSubscriptionsResponse.subscriptions:type_name -> job.v1.Subscription
+	0,  // 27: job.v1.StateCount.state:type_name -> job.v1.JobState
+	27, // 28: job.v1.InstanceStats.states:type_name -> job.v1.StateCount
+	33, // 29: job.v1.InstanceStats.oldest_scheduled_at:type_name -> google.protobuf.Timestamp
+	28, // 30: job.v1.KindStats.stats:type_name -> job.v1.InstanceStats
+	33, // 31: job.v1.Stats.timestamp:type_name -> google.protobuf.Timestamp
+	34, // 32: job.v1.Stats.window:type_name -> google.protobuf.Duration
+	28, // 33: job.v1.Stats.total:type_name -> job.v1.InstanceStats
+	29, // 34: job.v1.Stats.kinds:type_name -> job.v1.KindStats
+	34, // 35: job.v1.GetStatsRequest.window:type_name -> google.protobuf.Duration
+	30, // 36: job.v1.GetStatsResponse.stats:type_name -> job.v1.Stats
+	2,  // 37: job.v1.JobService.GetVersion:input_type -> job.v1.VersionRequest
+	6,  // 38: job.v1.JobService.ScheduleJob:input_type -> job.v1.ScheduleJobRequest
+	8,  // 39: job.v1.JobService.ListRegisteredJobs:input_type -> job.v1.ListRegisteredJobsRequest
+	11, // 40: job.v1.JobService.LookupInstances:input_type -> job.v1.LookupInstancesRequest
+	13, // 41: job.v1.JobService.CancelInstances:input_type -> job.v1.CancelInstancesRequest
+	15, // 42: job.v1.JobService.WaitInstance:input_type -> job.v1.WaitInstanceRequest
+	18, // 43: job.v1.JobService.LookupAuditRecords:input_type -> job.v1.LookupAuditRecordsRequest
+	21, // 44: job.v1.JobService.AddSubscription:input_type -> job.v1.AddSubscriptionRequest
+	23, // 45: job.v1.JobService.RemoveSubscription:input_type -> job.v1.RemoveSubscriptionRequest
+	25, // 46: job.v1.JobService.ListSubscriptions:input_type -> job.v1.ListSubscriptionsRequest
+	31, // 47: job.v1.JobService.GetStats:input_type -> job.v1.GetStatsRequest
+	3,  // 48: job.v1.JobService.GetVersion:output_type -> job.v1.VersionResponse
+	7,  // 49: job.v1.JobService.ScheduleJob:output_type -> job.v1.ScheduleJobResponse
+	9,  // 50: job.v1.JobService.ListRegisteredJobs:output_type -> job.v1.ListRegisteredJobsResponse
+	12, // 51: job.v1.JobService.LookupInstances:output_type -> job.v1.LookupInstancesResponse
+	14, // 52: job.v1.JobService.CancelInstances:output_type -> job.v1.CancelInstancesResponse
+	16, // 53: job.v1.JobService.WaitInstance:output_type -> job.v1.WaitInstanceResponse
+	19, // 54: job.v1.JobService.LookupAuditRecords:output_type -> job.v1.LookupAuditRecordsResponse
+	22, // 55: job.v1.JobService.AddSubscription:output_type -> job.v1.AddSubscriptionResponse
+	24, // 56: job.v1.JobService.RemoveSubscription:output_type -> job.v1.RemoveSubscriptionResponse
+	26, // 57: job.v1.JobService.ListSubscriptions:output_type -> job.v1.ListSubscriptionsResponse
+	32, // 58: job.v1.JobService.GetStats:output_type -> job.v1.GetStatsResponse
+	48, // [48:59] is the sub-list for method output_type
+	37, // [37:48] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -1009,13 +2267,17 @@ func file_service_proto_init() {
 	file_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_service_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
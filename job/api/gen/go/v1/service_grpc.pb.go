@@ -26,6 +26,12 @@ const (
 	JobService_ListRegisteredJobs_FullMethodName = "/job.v1.JobService/ListRegisteredJobs"
 	JobService_LookupInstances_FullMethodName    = "/job.v1.JobService/LookupInstances"
 	JobService_CancelInstances_FullMethodName    = "/job.v1.JobService/CancelInstances"
+	JobService_WaitInstance_FullMethodName       = "/job.v1.JobService/WaitInstance"
+	JobService_LookupAuditRecords_FullMethodName = "/job.v1.JobService/LookupAuditRecords"
+	JobService_AddSubscription_FullMethodName    = "/job.v1.JobService/AddSubscription"
+	JobService_RemoveSubscription_FullMethodName = "/job.v1.JobService/RemoveSubscription"
+	JobService_ListSubscriptions_FullMethodName  = "/job.v1.JobService/ListSubscriptions"
+	JobService_GetStats_FullMethodName           = "/job.v1.JobService/GetStats"
 )
 
 // JobServiceClient is the client API for JobService service.
@@ -47,6 +53,20 @@ type JobServiceClient interface {
 	LookupInstances(ctx context.Context, in *LookupInstancesRequest, opts ...grpc.CallOption) (*LookupInstancesResponse, error)
 	// CancelInstances cancels for job instances based on the provided query criteria.
 	CancelInstances(ctx context.Context, in *CancelInstancesRequest, opts ...grpc.CallOption) (*CancelInstancesResponse, error)
+	// WaitInstance waits until the specified job instance reaches a final state,
+	// and returns the job instance including its results or error.
+	WaitInstance(ctx context.Context, in *WaitInstanceRequest, opts ...grpc.CallOption) (*WaitInstanceResponse, error)
+	// LookupAuditRecords searches for audit records of administrative operations based on the provided query criteria.
+	LookupAuditRecords(ctx context.Context, in *LookupAuditRecordsRequest, opts ...grpc.CallOption) (*LookupAuditRecordsResponse, error)
+	// AddSubscription adds a webhook subscription which is notified of state changes of matching job instances.
+	AddSubscription(ctx context.Context, in *AddSubscriptionRequest, opts ...grpc.CallOption) (*AddSubscriptionResponse, error)
+	// RemoveSubscription removes the specified webhook subscription.
+	RemoveSubscription(ctx context.Context, in *RemoveSubscriptionRequest, opts ...grpc.CallOption) (*RemoveSubscriptionResponse, error)
+	// ListSubscriptions returns all webhook subscriptions.
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// GetStats returns aggregate statistics of job instances by kind and state, the queue and the workers,
+	// without returning the job instances.
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 }
 
 type jobServiceClient struct {
@@ -107,6 +127,66 @@ func (c *jobServiceClient) CancelInstances(ctx context.Context, in *CancelInstan
 	return out, nil
 }
 
+func (c *jobServiceClient) WaitInstance(ctx context.Context, in *WaitInstanceRequest, opts ...grpc.CallOption) (*WaitInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitInstanceResponse)
+	err := c.cc.Invoke(ctx, JobService_WaitInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) LookupAuditRecords(ctx context.Context, in *LookupAuditRecordsRequest, opts ...grpc.CallOption) (*LookupAuditRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, JobService_LookupAuditRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AddSubscription(ctx context.Context, in *AddSubscriptionRequest, opts ...grpc.CallOption) (*AddSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSubscriptionResponse)
+	err := c.cc.Invoke(ctx, JobService_AddSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RemoveSubscription(ctx context.Context, in *RemoveSubscriptionRequest, opts ...grpc.CallOption) (*RemoveSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSubscriptionResponse)
+	err := c.cc.Invoke(ctx, JobService_RemoveSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServiceServer is the server API for JobService service.
 // All implementations must embed UnimplementedJobServiceServer
 // for forward compatibility.
@@ -126,6 +206,20 @@ type JobServiceServer interface {
 	LookupInstances(context.Context, *LookupInstancesRequest) (*LookupInstancesResponse, error)
 	// CancelInstances cancels for job instances based on the provided query criteria.
 	CancelInstances(context.Context, *CancelInstancesRequest) (*CancelInstancesResponse, error)
+	// WaitInstance waits until the specified job instance reaches a final state,
+	// and returns the job instance including its results or error.
+	WaitInstance(context.Context, *WaitInstanceRequest) (*WaitInstanceResponse, error)
+	// LookupAuditRecords searches for audit records of administrative operations based on the provided query criteria.
+	LookupAuditRecords(context.Context, *LookupAuditRecordsRequest) (*LookupAuditRecordsResponse, error)
+	// AddSubscription adds a webhook subscription which is notified of state changes of matching job instances.
+	AddSubscription(context.Context, *AddSubscriptionRequest) (*AddSubscriptionResponse, error)
+	// RemoveSubscription removes the specified webhook subscription.
+	RemoveSubscription(context.Context, *RemoveSubscriptionRequest) (*RemoveSubscriptionResponse, error)
+	// ListSubscriptions returns all webhook subscriptions.
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// GetStats returns aggregate statistics of job instances by kind and state, the queue and the workers,
+	// without returning the job instances.
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	mustEmbedUnimplementedJobServiceServer()
 }
 
@@ -151,6 +245,24 @@ func (UnimplementedJobServiceServer) LookupInstances(context.Context, *LookupIns
 func (UnimplementedJobServiceServer) CancelInstances(context.Context, *CancelInstancesRequest) (*CancelInstancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelInstances not implemented")
 }
+func (UnimplementedJobServiceServer) WaitInstance(context.Context, *WaitInstanceRequest) (*WaitInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitInstance not implemented")
+}
+func (UnimplementedJobServiceServer) LookupAuditRecords(context.Context, *LookupAuditRecordsRequest) (*LookupAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupAuditRecords not implemented")
+}
+func (UnimplementedJobServiceServer) AddSubscription(context.Context, *AddSubscriptionRequest) (*AddSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubscription not implemented")
+}
+func (UnimplementedJobServiceServer) RemoveSubscription(context.Context, *RemoveSubscriptionRequest) (*RemoveSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubscription not implemented")
+}
+func (UnimplementedJobServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedJobServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
 func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
 func (UnimplementedJobServiceServer) testEmbeddedByValue()                    {}
 
@@ -262,6 +374,114 @@ func _JobService_CancelInstances_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_WaitInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).WaitInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_WaitInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).WaitInstance(ctx, req.(*WaitInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_LookupAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).LookupAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_LookupAuditRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).LookupAuditRecords(ctx, req.(*LookupAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AddSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AddSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_AddSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AddSubscription(ctx, req.(*AddSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RemoveSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RemoveSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RemoveSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RemoveSubscription(ctx, req.(*RemoveSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +509,30 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelInstances",
 			Handler:    _JobService_CancelInstances_Handler,
 		},
+		{
+			MethodName: "WaitInstance",
+			Handler:    _JobService_WaitInstance_Handler,
+		},
+		{
+			MethodName: "LookupAuditRecords",
+			Handler:    _JobService_LookupAuditRecords_Handler,
+		},
+		{
+			MethodName: "AddSubscription",
+			Handler:    _JobService_AddSubscription_Handler,
+		},
+		{
+			MethodName: "RemoveSubscription",
+			Handler:    _JobService_RemoveSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _JobService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _JobService_GetStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
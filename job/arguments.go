@@ -22,14 +22,21 @@ import (
 type Arguments interface {
 	// Arguments returns the underlying arguments.
 	Arguments() []any
+	// NamedArguments returns the underlying named arguments.
+	NamedArguments() NamedArguments
 	// Map returns the arguments as a map.
 	Map() map[string]any
 	// String returns a string representation of the arguments.
 	String() string
 }
 
+// NamedArguments represents named (keyword) arguments for a job executor.
+// Named arguments are bound to executor parameters by name instead of by position.
+type NamedArguments map[string]any
+
 type argumentsImpl struct {
-	Args []any `json:"args"`
+	Args      []any          `json:"args"`
+	NamedArgs NamedArguments `json:"named_args"`
 }
 
 // ArgumentsOption defines a function that configures the arguments for a job.
@@ -42,6 +49,15 @@ func WithArguments(args ...any) ArgumentsOption {
 	}
 }
 
+// WithNamedArguments sets the named arguments for a job.
+// Named arguments are bound to fields of struct parameters by field name or `job` tag,
+// and to parameters named by WithArgumentNames().
+func WithNamedArguments(args map[string]any) ArgumentsOption {
+	return func(a *argumentsImpl) {
+		a.NamedArgs = NamedArguments(args)
+	}
+}
+
 // newArgumentsWith creates a new Arguments instance with the provided arguments.
 func newArgumentsWith(args ...any) Arguments {
 	return newArguments(WithArguments(args...))
@@ -85,9 +101,30 @@ func newArgumentsFrom(args any) (Arguments, error) {
 	return nil, fmt.Errorf("unsupported type for arguments: %T", args)
 }
 
+// newNamedArgumentsFrom creates a new NamedArguments instance from the provided arguments.
+// It supports various types such as nil, map[string]any, and JSON string.
+func newNamedArgumentsFrom(args any) (NamedArguments, error) {
+	switch v := args.(type) {
+	case nil:
+		return NamedArguments{}, nil
+	case NamedArguments:
+		return v, nil
+	case map[string]any:
+		return NamedArguments(v), nil
+	case string:
+		var m map[string]any
+		if err := json.Unmarshal([]byte(v), &m); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal named arguments: %w", err)
+		}
+		return NamedArguments(m), nil
+	}
+	return nil, fmt.Errorf("unsupported type for named arguments: %T", args)
+}
+
 func newArguments(opts ...ArgumentsOption) *argumentsImpl {
 	args := &argumentsImpl{
-		Args: make([]any, 0),
+		Args:      make([]any, 0),
+		NamedArgs: NamedArguments{},
 	}
 	for _, opt := range opts {
 		opt(args)
@@ -100,11 +137,20 @@ func (args *argumentsImpl) Arguments() []any {
 	return args.Args
 }
 
+// NamedArguments returns the underlying named arguments.
+func (args *argumentsImpl) NamedArguments() NamedArguments {
+	return args.NamedArgs
+}
+
 // Map returns the arguments as a map.
 func (args *argumentsImpl) Map() map[string]any {
-	return map[string]any{
+	m := map[string]any{
 		argumentsKey: args.String(),
 	}
+	if 0 < len(args.NamedArgs) {
+		m[namedArgumentsKey] = args.NamedArgs.String()
+	}
+	return m
 }
 
 // JSONString returns the arguments as a JSON string.
@@ -124,3 +170,21 @@ func (args *argumentsImpl) String() string {
 	}
 	return fmt.Sprintf("%v", args.Args)
 }
+
+// JSONString returns the named arguments as a JSON string.
+func (args NamedArguments) JSONString() (string, error) {
+	b, err := json.Marshal(map[string]any(args))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal named arguments to JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+// String returns a string representation of the named arguments.
+func (args NamedArguments) String() string {
+	jsonStr, err := args.JSONString()
+	if err == nil {
+		return jsonStr
+	}
+	return fmt.Sprintf("%v", map[string]any(args))
+}
@@ -40,3 +40,43 @@ func TestArgumentsFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestNamedArgumentsFrom(t *testing.T) {
+	tests := []struct {
+		v        any
+		expected int
+	}{
+		{v: nil, expected: 0},
+		{v: map[string]any{"a": 1, "b": 2}, expected: 2},
+		{v: NamedArguments{"a": 1}, expected: 1},
+		{v: "{\"a\": 1, \"b\": \"x\"}", expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("NamedArgumentsFrom %T", tt.v), func(t *testing.T) {
+			args, err := newNamedArgumentsFrom(tt.v)
+			if err != nil {
+				t.Errorf("newNamedArgumentsFrom(%T) returned error: %v", tt.v, err)
+				return
+			}
+			if len(args) != tt.expected {
+				t.Errorf("expected %d named arguments, got %d", tt.expected, len(args))
+			}
+		})
+	}
+}
+
+func TestNamedArgumentsMap(t *testing.T) {
+	args := newArguments(
+		WithArguments(1),
+		WithNamedArguments(map[string]any{"b": 2}),
+	)
+	im := newInstanceMapWith(args.Map())
+	namedArgs, ok := im.NamedArguments()
+	if !ok {
+		t.Fatalf("expected named arguments in map: %v", args.Map())
+	}
+	if v, ok := namedArgs["b"]; !ok || v != float64(2) {
+		t.Errorf("expected named argument b=2, got %v", namedArgs)
+	}
+}
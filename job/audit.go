@@ -0,0 +1,337 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditOperation represents an administrative operation recorded in the audit log.
+type AuditOperation string
+
+const (
+	// AuditRegisterJob represents a job registration.
+	AuditRegisterJob AuditOperation = "register_job"
+	// AuditUnregisterJob represents a job unregistration.
+	AuditUnregisterJob AuditOperation = "unregister_job"
+	// AuditScheduleJob represents a job scheduling.
+	AuditScheduleJob AuditOperation = "schedule_job"
+	// AuditCancelInstances represents a job instance cancellation.
+	AuditCancelInstances AuditOperation = "cancel_instances"
+	// AuditClearHistory represents a job instance history clearing.
+	AuditClearHistory AuditOperation = "clear_history"
+	// AuditClearLogs represents a job instance log clearing.
+	AuditClearLogs AuditOperation = "clear_logs"
+	// AuditClear represents a clearing of all jobs, history and logs.
+	AuditClear AuditOperation = "clear"
+	// AuditAddSubscription represents a webhook subscription addition.
+	AuditAddSubscription AuditOperation = "add_subscription"
+	// AuditRemoveSubscription represents a webhook subscription removal.
+	AuditRemoveSubscription AuditOperation = "remove_subscription"
+)
+
+const (
+	// DefaultAuditActor is the default actor of operations made through the manager.
+	DefaultAuditActor = "manager"
+)
+
+const (
+	actorKey     = "actor"
+	operationKey = "operation"
+	queryKey     = "query"
+	uuidsKey     = "uuids"
+)
+
+// AuditRecord represents an audit record of an administrative operation.
+type AuditRecord interface {
+	// ID returns the unique identifier of the audit record.
+	ID() UUID
+	// Timestamp returns the timestamp of the operation.
+	Timestamp() time.Time
+	// Actor returns the actor who made the operation.
+	Actor() string
+	// Operation returns the operation.
+	Operation() AuditOperation
+	// Kind returns the job kind of the operation, or an empty string if the operation has no job kind.
+	Kind() string
+	// Query returns the query or filter of the operation, or an empty string if the operation has no query.
+	Query() string
+	// UUIDs returns the UUIDs of the job instances affected by the operation.
+	UUIDs() []UUID
+	// Map returns a map representation of the audit record.
+	Map() map[string]any
+	// String returns the string representation of the audit record.
+	String() string
+}
+
+type auditRecord struct {
+	id        UUID
+	ts        time.Time
+	actor     string
+	operation AuditOperation
+	kind      string
+	query     string
+	uuids     []UUID
+}
+
+// AuditRecordOption defines a function that configures an audit record.
+type AuditRecordOption func(*auditRecord)
+
+// WithAuditRecordID sets the unique identifier of the audit record.
+func WithAuditRecordID(id UUID) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.id = id
+	}
+}
+
+// WithAuditRecordTimestamp sets the timestamp of the audit record.
+func WithAuditRecordTimestamp(ts time.Time) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.ts = ts
+	}
+}
+
+// WithAuditRecordActor sets the actor of the audit record.
+func WithAuditRecordActor(actor string) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.actor = actor
+	}
+}
+
+// WithAuditRecordOperation sets the operation of the audit record.
+func WithAuditRecordOperation(operation AuditOperation) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.operation = operation
+	}
+}
+
+// WithAuditRecordKind sets the job kind of the audit record.
+func WithAuditRecordKind(kind string) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.kind = kind
+	}
+}
+
+// WithAuditRecordQuery sets the query or filter of the audit record.
+func WithAuditRecordQuery(query string) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.query = query
+	}
+}
+
+// WithAuditRecordUUIDs sets the UUIDs of the job instances affected by the operation.
+func WithAuditRecordUUIDs(uuids ...UUID) AuditRecordOption {
+	return func(r *auditRecord) {
+		r.uuids = uuids
+	}
+}
+
+// NewAuditRecord creates a new audit record with the specified options.
+func NewAuditRecord(opts ...AuditRecordOption) AuditRecord {
+	r := &auditRecord{
+		id:        NewUUID(),
+		ts:        time.Now(),
+		actor:     "",
+		operation: "",
+		kind:      "",
+		query:     "",
+		uuids:     []UUID{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// NewAuditRecordFromMap creates a new audit record from a map representation.
+// Audit records which were stored without identifiers are identified by their contents, so that they keep the same identifiers on every lookup.
+func NewAuditRecordFromMap(m map[string]any) (AuditRecord, error) {
+	opts := []AuditRecordOption{}
+	hasID := false
+	for key, value := range m {
+		switch key {
+		case idKey:
+			id, err := NewUUIDFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithAuditRecordID(id))
+			hasID = true
+		case timestampKey:
+			ts, err := NewTimestampFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithAuditRecordTimestamp(ts.Time()))
+		case actorKey:
+			if actor, ok := value.(string); ok {
+				opts = append(opts, WithAuditRecordActor(actor))
+			}
+		case operationKey:
+			if operation, ok := value.(string); ok {
+				opts = append(opts, WithAuditRecordOperation(AuditOperation(operation)))
+			}
+		case kindKey:
+			kind, err := newKindFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithAuditRecordKind(kind))
+		case queryKey:
+			if query, ok := value.(string); ok {
+				opts = append(opts, WithAuditRecordQuery(query))
+			}
+		case uuidsKey:
+			var values []any
+			switch v := value.(type) {
+			case []any:
+				values = v
+			case []string:
+				for _, s := range v {
+					values = append(values, s)
+				}
+			default:
+				return nil, fmt.Errorf("%w audit record uuids: %v", ErrInvalid, value)
+			}
+			uuids := make([]UUID, 0, len(values))
+			for _, v := range values {
+				uuid, err := NewUUIDFrom(v)
+				if err != nil {
+					return nil, err
+				}
+				uuids = append(uuids, uuid)
+			}
+			opts = append(opts, WithAuditRecordUUIDs(uuids...))
+		}
+	}
+	record := NewAuditRecord(opts...)
+	if !hasID {
+		if r, ok := record.(*auditRecord); ok {
+			r.id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(r.String()))
+		}
+	}
+	return record, nil
+}
+
+// ID returns the unique identifier of the audit record.
+func (r *auditRecord) ID() UUID {
+	return r.id
+}
+
+// Timestamp returns the timestamp of the operation.
+func (r *auditRecord) Timestamp() time.Time {
+	return r.ts
+}
+
+// Actor returns the actor who made the operation.
+func (r *auditRecord) Actor() string {
+	return r.actor
+}
+
+// Operation returns the operation.
+func (r *auditRecord) Operation() AuditOperation {
+	return r.operation
+}
+
+// Kind returns the job kind of the operation, or an empty string if the operation has no job kind.
+func (r *auditRecord) Kind() string {
+	return r.kind
+}
+
+// Query returns the query or filter of the operation, or an empty string if the operation has no query.
+func (r *auditRecord) Query() string {
+	return r.query
+}
+
+// UUIDs returns the UUIDs of the job instances affected by the operation.
+func (r *auditRecord) UUIDs() []UUID {
+	return r.uuids
+}
+
+// Map returns a map representation of the audit record.
+func (r *auditRecord) Map() map[string]any {
+	uuids := make([]any, len(r.uuids))
+	for n, uuid := range r.uuids {
+		uuids[n] = uuid.String()
+	}
+	return map[string]any{
+		idKey:        r.id.String(),
+		timestampKey: NewTimestampFromTime(r.ts).String(),
+		actorKey:     r.actor,
+		operationKey: string(r.operation),
+		kindKey:      r.kind,
+		queryKey:     r.query,
+		uuidsKey:     uuids,
+	}
+}
+
+// String returns the string representation of the audit record.
+func (r *auditRecord) String() string {
+	s := NewTimestampFromTime(r.ts).String() + " " + r.actor + " " + string(r.operation)
+	if 0 < len(r.kind) {
+		s += " kind=" + r.kind
+	}
+	if 0 < len(r.query) {
+		s += " query=(" + r.query + ")"
+	}
+	if 0 < len(r.uuids) {
+		uuids := make([]string, len(r.uuids))
+		for n, uuid := range r.uuids {
+			uuids[n] = uuid.String()
+		}
+		s += " uuids=" + strings.Join(uuids, ",")
+	}
+	return s
+}
+
+// newAuditQueryString returns a string representation of the query criteria for audit records.
+func newAuditQueryString(q Query) string {
+	if q == nil {
+		return ""
+	}
+	criteria := []string{}
+	if uuid, ok := q.UUID(); ok {
+		criteria = append(criteria, "uuid="+uuid.String())
+	}
+	if kind, ok := q.Kind(); ok {
+		criteria = append(criteria, "kind="+kind)
+	}
+	if state, ok := q.State(); ok {
+		criteria = append(criteria, "state="+state.String())
+	}
+	if filter := newAuditFilterString(q); 0 < len(filter) {
+		criteria = append(criteria, filter)
+	}
+	return strings.Join(criteria, " ")
+}
+
+// newAuditFilterString returns a string representation of the filter criteria for audit records.
+func newAuditFilterString(f Filter) string {
+	if f == nil {
+		return ""
+	}
+	criteria := []string{}
+	if before, ok := f.Before(); ok {
+		criteria = append(criteria, "before="+NewTimestampFromTime(before).String())
+	}
+	if after, ok := f.After(); ok {
+		criteria = append(criteria, "after="+NewTimestampFromTime(after).String())
+	}
+	return strings.Join(criteria, " ")
+}
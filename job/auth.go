@@ -0,0 +1,179 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// AuthMethodToken represents the bearer token authentication method.
+	AuthMethodToken = "token"
+	// AuthMethodTLS represents the mutual TLS authentication method.
+	AuthMethodTLS = "mtls"
+	// AnonymousIdentityName is the identity name of unauthenticated callers when no authenticator is set.
+	AnonymousIdentityName = "anonymous"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Identity represents an authenticated caller of the job service.
+type Identity interface {
+	// Name returns the name of the identity.
+	Name() string
+	// Method returns the authentication method of the identity.
+	Method() string
+	// String returns a string representation of the identity.
+	String() string
+}
+
+type identity struct {
+	name   string
+	method string
+}
+
+// NewIdentity returns a new identity with the specified name and authentication method.
+func NewIdentity(name string, method string) Identity {
+	return &identity{
+		name:   name,
+		method: method,
+	}
+}
+
+// Name returns the name of the identity.
+func (id *identity) Name() string {
+	return id.name
+}
+
+// Method returns the authentication method of the identity.
+func (id *identity) Method() string {
+	return id.method
+}
+
+// String returns a string representation of the identity.
+func (id *identity) String() string {
+	if len(id.method) == 0 {
+		return id.name
+	}
+	return id.name + " (" + id.method + ")"
+}
+
+type identityContextKey struct{}
+
+// withIdentity returns a copy of the context with the specified identity.
+func withIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, id)
+}
+
+// IdentityFromContext returns the authenticated identity of the context.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityContextKey{}).(Identity)
+	return id, ok
+}
+
+// Authenticator is an interface that authenticates callers of the job service.
+type Authenticator interface {
+	// Authenticate returns the identity of the caller of the specified request context.
+	// It returns an error which wraps ErrUnauthenticated if the caller has no valid credentials for the authenticator.
+	Authenticate(ctx context.Context) (Identity, error)
+}
+
+type tokenAuthenticator struct {
+	tokens map[string]string
+}
+
+// NewTokenAuthenticator returns a new authenticator which authenticates callers by static bearer tokens.
+// The specified map maps each token to its identity name.
+func NewTokenAuthenticator(tokens map[string]string) Authenticator {
+	return &tokenAuthenticator{
+		tokens: tokens,
+	}
+}
+
+// Authenticate returns the identity of the bearer token in the request metadata.
+func (auth *tokenAuthenticator) Authenticate(ctx context.Context) (Identity, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no bearer token: %w", ErrUnauthenticated)
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if !strings.HasPrefix(value, bearerPrefix) {
+			continue
+		}
+		token := strings.TrimPrefix(value, bearerPrefix)
+		for knownToken, name := range auth.tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(knownToken)) == 1 {
+				return NewIdentity(name, AuthMethodToken), nil
+			}
+		}
+		return nil, fmt.Errorf("invalid bearer token: %w", ErrUnauthenticated)
+	}
+	return nil, fmt.Errorf("no bearer token: %w", ErrUnauthenticated)
+}
+
+type tlsAuthenticator struct{}
+
+// NewTLSAuthenticator returns a new authenticator which authenticates callers by verified client certificates of mutual TLS.
+// The identity name is the common name of the client certificate subject.
+func NewTLSAuthenticator() Authenticator {
+	return &tlsAuthenticator{}
+}
+
+// Authenticate returns the identity of the verified client certificate of the request.
+func (auth *tlsAuthenticator) Authenticate(ctx context.Context) (Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no peer: %w", ErrUnauthenticated)
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, fmt.Errorf("no TLS connection: %w", ErrUnauthenticated)
+	}
+	chains := tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, fmt.Errorf("no verified client certificate: %w", ErrUnauthenticated)
+	}
+	name := chains[0][0].Subject.CommonName
+	if len(name) == 0 {
+		return nil, fmt.Errorf("no common name in client certificate: %w", ErrUnauthenticated)
+	}
+	return NewIdentity(name, AuthMethodTLS), nil
+}
+
+// authenticate authenticates the caller with the specified authenticators in order, and returns the first authenticated identity.
+func authenticate(ctx context.Context, authenticators []Authenticator) (Identity, error) {
+	if len(authenticators) == 0 {
+		return NewIdentity(AnonymousIdentityName, ""), nil
+	}
+	var lastErr error
+	for _, auth := range authenticators {
+		id, err := auth.Authenticate(ctx)
+		if err == nil {
+			return id, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
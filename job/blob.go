@@ -0,0 +1,238 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// DefaultClaimCheckThreshold is the default size in bytes of JSON-encoded arguments above which the arguments are offloaded to the blob store.
+	DefaultClaimCheckThreshold = 256 * 1024
+)
+
+// BlobStore defines the interface for storing large payloads out of band.
+type BlobStore interface {
+	// Name returns the name of the blob store.
+	Name() string
+	// PutBlob stores a blob with the specified name for a job instance.
+	PutBlob(ctx context.Context, uuid UUID, name string, data []byte) error
+	// GetBlob returns the blob with the specified name for a job instance.
+	GetBlob(ctx context.Context, uuid UUID, name string) ([]byte, error)
+	// DeleteBlobs deletes all blobs for a job instance.
+	DeleteBlobs(ctx context.Context, uuid UUID) error
+	// ClearBlobs deletes all blobs in the blob store.
+	ClearBlobs(ctx context.Context) error
+}
+
+const (
+	// claimCheckKey is the reserved key of claim check references which replace offloaded arguments.
+	// The key is namespaced so that user arguments do not collide with claim check references.
+	claimCheckKey = "$go-job.claim_check"
+	// claimCheckVersionKey is the key of the claim check reference format version.
+	claimCheckVersionKey = "version"
+	// claimCheckNameKey is the key of the blob name in claim check references.
+	claimCheckNameKey = "name"
+	// claimCheckVersion is the current claim check reference format version.
+	claimCheckVersion = 1
+)
+
+// newClaimCheck returns a claim check reference to the blob with the specified name.
+func newClaimCheck(name string) map[string]any {
+	return map[string]any{
+		claimCheckKey: map[string]any{
+			claimCheckVersionKey: claimCheckVersion,
+			claimCheckNameKey:    name,
+		},
+	}
+}
+
+// claimCheckFrom returns the blob name if the specified argument is a claim check reference.
+// Only maps which have the reserved key alone with a known version and a blob name are regarded as claim check references.
+func claimCheckFrom(arg any) (string, bool) {
+	m, ok := arg.(map[string]any)
+	if !ok || len(m) != 1 {
+		return "", false
+	}
+	ref, ok := m[claimCheckKey].(map[string]any)
+	if !ok || len(ref) != 2 {
+		return "", false
+	}
+	// The version is decoded as a float64 from the persisted JSON arguments.
+	if fmt.Sprint(ref[claimCheckVersionKey]) != strconv.Itoa(claimCheckVersion) {
+		return "", false
+	}
+	name, ok := ref[claimCheckNameKey].(string)
+	if !ok || len(name) == 0 {
+		return "", false
+	}
+	return name, true
+}
+
+// claimChecker offloads large arguments of job instances to a blob store and fetches them again.
+type claimChecker struct {
+	store     BlobStore
+	threshold int
+}
+
+// newClaimChecker returns a new claim checker which offloads arguments larger than the threshold in bytes.
+func newClaimChecker(store BlobStore, threshold int) *claimChecker {
+	return &claimChecker{
+		store:     store,
+		threshold: threshold,
+	}
+}
+
+// offload stores the arguments of the job instance which are larger than the threshold in the blob store,
+// and replaces them with claim check references.
+func (cc *claimChecker) offload(ctx context.Context, ji *jobInstance) error {
+	offloadArg := func(name string, arg any) (any, error) {
+		if _, ok := claimCheckFrom(arg); ok {
+			return arg, nil
+		}
+		data, err := json.Marshal(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) <= cc.threshold {
+			return arg, nil
+		}
+		if err := cc.store.PutBlob(ctx, ji.UUID(), name, data); err != nil {
+			return nil, fmt.Errorf("failed to offload argument (%s) of job instance %s: %w", name, ji.UUID(), err)
+		}
+		return newClaimCheck(name), nil
+	}
+
+	args := make([]any, len(ji.Arguments()))
+	for n, arg := range ji.Arguments() {
+		v, err := offloadArg("args-"+strconv.Itoa(n), arg)
+		if err != nil {
+			return err
+		}
+		args[n] = v
+	}
+	namedArgs := NamedArguments{}
+	for name, arg := range ji.NamedArguments() {
+		v, err := offloadArg("named-"+url.PathEscape(name), arg)
+		if err != nil {
+			return err
+		}
+		namedArgs[name] = v
+	}
+	ji.argumentsImpl.Args = args
+	ji.argumentsImpl.NamedArgs = namedArgs
+	return nil
+}
+
+// fetch returns copies of the arguments of the job instance whose claim check references are replaced with the offloaded values.
+func (cc *claimChecker) fetch(ctx context.Context, ji *jobInstance) ([]any, NamedArguments, error) {
+	fetchArg := func(arg any) (any, error) {
+		name, ok := claimCheckFrom(arg)
+		if !ok {
+			return arg, nil
+		}
+		if cc == nil || cc.store == nil {
+			return nil, fmt.Errorf("no blob store to fetch argument (%s) of job instance %s", name, ji.UUID())
+		}
+		data, err := cc.store.GetBlob(ctx, ji.UUID(), name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch argument (%s) of job instance %s: %w", name, ji.UUID(), err)
+		}
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+
+	args := make([]any, len(ji.Arguments()))
+	for n, arg := range ji.Arguments() {
+		v, err := fetchArg(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		args[n] = v
+	}
+	var namedArgs NamedArguments
+	if 0 < len(ji.NamedArguments()) {
+		namedArgs = NamedArguments{}
+		for name, arg := range ji.NamedArguments() {
+			v, err := fetchArg(arg)
+			if err != nil {
+				return nil, nil, err
+			}
+			namedArgs[name] = v
+		}
+	}
+	return args, namedArgs, nil
+}
+
+// clearHistory clears the state records which match the specified filter, and deletes the blobs of the job instances
+// which have no remaining state records and are no longer queued.
+func (cc *claimChecker) clearHistory(ctx context.Context, history StateHistory, queue InstanceQueue, filter Filter) error {
+	opts := []QueryOption{}
+	if before, ok := filter.Before(); ok {
+		opts = append(opts, WithQueryBefore(before))
+	}
+	if after, ok := filter.After(); ok {
+		opts = append(opts, WithQueryAfter(after))
+	}
+	records, err := history.LookupHistory(NewQuery(opts...))
+	if err != nil {
+		return err
+	}
+	uuids := map[UUID]bool{}
+	for _, record := range records {
+		uuids[record.UUID()] = true
+	}
+
+	if err := history.ClearHistory(filter); err != nil {
+		return err
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	for uuid := range uuids {
+		records, err := history.LookupHistory(NewQuery(WithQueryUUID(uuid), WithQueryLimit(1)))
+		if err != nil {
+			return err
+		}
+		if 0 < len(records) {
+			delete(uuids, uuid)
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+	queued, err := queue.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ji := range queued {
+		delete(uuids, ji.UUID())
+	}
+
+	for uuid := range uuids {
+		if err := cc.store.DeleteBlobs(ctx, uuid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,10 @@
 
 package job
 
+import (
+	"context"
+)
+
 // Client represents a gRPC client.
 type Client interface {
 	// Name returns the name of the client.
@@ -22,6 +26,10 @@ type Client interface {
 	SetHost(host string)
 	// SetPort sets a port number.
 	SetPort(port int)
+	// SetTLSConfig sets the TLS configuration. TLS is used if the configuration is enabled.
+	SetTLSConfig(config TLSConfig)
+	// SetAuthToken sets the bearer token to authenticate the client.
+	SetAuthToken(token string)
 	// Open opens a connection.
 	Open() error
 	// Close closes the connection.
@@ -36,6 +44,18 @@ type Client interface {
 	LookupInstances(query Query) ([]Instance, error)
 	// CancelInstances cancels job instances based on the provided query.
 	CancelInstances(query Query) ([]Instance, error)
+	// WaitInstance waits until the specified job instance reaches a final state, and returns the job instance including its results or error.
+	WaitInstance(ctx context.Context, uuid UUID) (Instance, error)
+	// LookupAuditRecords looks up audit records of administrative operations based on the provided query.
+	LookupAuditRecords(query Query) ([]AuditRecord, error)
+	// AddSubscription adds a webhook subscription notified of instance state changes, and returns the added subscription.
+	AddSubscription(sub Subscription) (Subscription, error)
+	// RemoveSubscription removes the webhook subscription with the specified identifier.
+	RemoveSubscription(id UUID) error
+	// ListSubscriptions lists all webhook subscriptions.
+	ListSubscriptions() ([]Subscription, error)
+	// GetStats retrieves the aggregate statistics of job instances by kind and state, the queue and the workers.
+	GetStats(opts ...StatsOption) (Stats, error)
 }
 
 // NewClient returns a new default gRPC client.
@@ -15,10 +15,12 @@
 package job
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
 	"strconv"
+	"time"
 )
 
 const (
@@ -68,6 +70,28 @@ func (cli *cliClient) SetHost(host string) {
 	cli.args = append(cli.args, "--host", host)
 }
 
+// SetTLSConfig sets the TLS configuration. TLS is used if the configuration is enabled.
+func (cli *cliClient) SetTLSConfig(config TLSConfig) {
+	if !config.IsTLSEnabled() {
+		return
+	}
+	cli.args = append(cli.args, "--tls")
+	if file := config.TLSCertFile(); 0 < len(file) {
+		cli.args = append(cli.args, "--tls-cert", file)
+	}
+	if file := config.TLSKeyFile(); 0 < len(file) {
+		cli.args = append(cli.args, "--tls-key", file)
+	}
+	if file := config.TLSCAFile(); 0 < len(file) {
+		cli.args = append(cli.args, "--tls-ca", file)
+	}
+}
+
+// SetAuthToken sets the bearer token to authenticate the client.
+func (cli *cliClient) SetAuthToken(token string) {
+	cli.args = append(cli.args, "--token", token)
+}
+
 // SetCommandExecutor sets the command executor.
 func (cli *cliClient) SetCommandExecutor(executor CommandExecutor) {
 	cli.executor = executor
@@ -103,6 +127,43 @@ func (cli *cliClient) trimOutput(out []byte) string {
 	return string(out)
 }
 
+// newCLIPageArgs returns the command arguments of the limit, the offset, the order and the page token of the query.
+func newCLIPageArgs(query Query) []string {
+	args := []string{}
+	if limit, ok := query.Limit(); ok {
+		args = append(args, "--limit", strconv.Itoa(limit))
+	}
+	if offset := query.Offset(); 0 < offset {
+		args = append(args, "--offset", strconv.Itoa(offset))
+	}
+	if order := query.Order(); order != SortAscending {
+		args = append(args, "--order", order.String())
+	}
+	if token, ok := query.PageToken(); ok {
+		args = append(args, "--page-token", token.String())
+	}
+	return args
+}
+
+// unmarshalCLIPage unmarshals the results of the command output, which are printed with the page token of the next page if the query has a limit.
+func unmarshalCLIPage(out []byte, query Query, key string) ([]map[string]any, error) {
+	var maps []map[string]any
+	if _, ok := query.Limit(); !ok {
+		if err := json.Unmarshal(out, &maps); err != nil {
+			return nil, err
+		}
+		return maps, nil
+	}
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(out, &page); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(page[key], &maps); err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
 // GetVersion retrieves the version of the job service.
 func (cli *cliClient) GetVersion() (string, error) {
 	var cmdArgs []string
@@ -168,12 +229,13 @@ func (cli *cliClient) LookupInstances(query Query) ([]Instance, error) {
 	var cmdArgs []string
 	cmdArgs = append(cmdArgs, cli.args...)
 	cmdArgs = append(cmdArgs, "list", "instances")
+	cmdArgs = append(cmdArgs, newCLIPageArgs(query)...)
 	out, err := cli.Execute(jobctl, cmdArgs...)
 	if err != nil {
 		return nil, err
 	}
-	var maps []map[string]any
-	if err := json.Unmarshal(out, &maps); err != nil {
+	maps, err := unmarshalCLIPage(out, query, "instances")
+	if err != nil {
 		return nil, err
 	}
 	instances := make([]Instance, len(maps))
@@ -210,3 +272,121 @@ func (cli *cliClient) CancelInstances(query Query) ([]Instance, error) {
 	}
 	return instances, nil
 }
+
+// WaitInstance waits until the specified job instance reaches a final state, and returns the job instance including its results or error.
+func (cli *cliClient) WaitInstance(ctx context.Context, uuid UUID) (Instance, error) {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "wait", "instance", uuid.String())
+	if deadline, ok := ctx.Deadline(); ok {
+		cmdArgs = append(cmdArgs, "--timeout", time.Until(deadline).String())
+	}
+	out, err := cli.Execute(jobctl, cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(out, &m); err != nil {
+		return nil, err
+	}
+	return NewInstanceFromMap(m)
+}
+
+// LookupAuditRecords looks up audit records of administrative operations based on the provided query.
+func (cli *cliClient) LookupAuditRecords(query Query) ([]AuditRecord, error) {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "list", "audit")
+	cmdArgs = append(cmdArgs, newCLIPageArgs(query)...)
+	out, err := cli.Execute(jobctl, cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	maps, err := unmarshalCLIPage(out, query, "records")
+	if err != nil {
+		return nil, err
+	}
+	records := make([]AuditRecord, len(maps))
+	for n, m := range maps {
+		r, err := NewAuditRecordFromMap(m)
+		if err != nil {
+			return nil, err
+		}
+		records[n] = r
+	}
+	return records, nil
+}
+
+// AddSubscription adds a webhook subscription notified of instance state changes, and returns the added subscription.
+func (cli *cliClient) AddSubscription(sub Subscription) (Subscription, error) {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "add", "subscription", sub.URL())
+	if kind := sub.Kind(); 0 < len(kind) {
+		cmdArgs = append(cmdArgs, "--kind", kind)
+	}
+	for _, state := range sub.States() {
+		cmdArgs = append(cmdArgs, "--state", state.String())
+	}
+	if secret := sub.Secret(); 0 < len(secret) {
+		cmdArgs = append(cmdArgs, "--secret", secret)
+	}
+	out, err := cli.Execute(jobctl, cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(out, &m); err != nil {
+		return nil, err
+	}
+	return NewSubscriptionFromMap(m)
+}
+
+// RemoveSubscription removes the webhook subscription with the specified identifier.
+func (cli *cliClient) RemoveSubscription(id UUID) error {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "remove", "subscription", id.String())
+	_, err := cli.Execute(jobctl, cmdArgs...)
+	return err
+}
+
+// ListSubscriptions lists all webhook subscriptions.
+func (cli *cliClient) ListSubscriptions() ([]Subscription, error) {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "list", "subscriptions")
+	out, err := cli.Execute(jobctl, cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	var maps []map[string]any
+	if err := json.Unmarshal(out, &maps); err != nil {
+		return nil, err
+	}
+	subs := make([]Subscription, len(maps))
+	for n, m := range maps {
+		sub, err := NewSubscriptionFromMap(m)
+		if err != nil {
+			return nil, err
+		}
+		subs[n] = sub
+	}
+	return subs, nil
+}
+
+// GetStats returns the aggregate statistics of the job instances, the queue and the workers.
+func (cli *cliClient) GetStats(opts ...StatsOption) (Stats, error) {
+	var cmdArgs []string
+	cmdArgs = append(cmdArgs, cli.args...)
+	cmdArgs = append(cmdArgs, "get", "stats", "--window", newStats(opts...).Window().String())
+	out, err := cli.Execute(jobctl, cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(out, &m); err != nil {
+		return nil, err
+	}
+	return NewStatsFromMap(m)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/cybergarage/go-job/job"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+	addCmd.AddCommand(addSubscriptionCmd)
+	addSubscriptionCmd.Flags().StringP("kind", "k", "", "Glob pattern of the job kinds to notify (empty means all kinds)")
+	addSubscriptionCmd.Flags().StringSliceP("state", "s", nil, "Job state to notify, such as Completed or Terminated (repeatable, empty means all states)")
+	addSubscriptionCmd.Flags().String("secret", "", "Secret key to sign the notification payloads")
+}
+
+var addCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "add",
+	Short: "Add the specified resource",
+	Long:  "Add the specified resource in the specified category.",
+}
+
+var addSubscriptionCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "subscription url",
+	Short: "Add a webhook subscription",
+	Long:  "Add a webhook subscription notified of the state changes of job instances.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			cmd.Help()
+			return errInvalidArguments(args)
+		}
+
+		kind, _ := cmd.Flags().GetString("kind")
+		states, _ := cmd.Flags().GetStringSlice("state")
+		secret, _ := cmd.Flags().GetString("secret")
+		sub, err := job.NewSubscriptionFromMap(map[string]any{
+			"url":    args[0],
+			"kind":   kind,
+			"states": states,
+			"secret": secret,
+		})
+		if err != nil {
+			return err
+		}
+
+		sub, err = GetClient().AddSubscription(sub)
+		if err != nil {
+			return err
+		}
+		return printSubscription(cmd, sub)
+	},
+	Args:    cobra.ExactArgs(1),
+	Example: `jobctl add subscription https://example.com/hooks/billing --kind "billing.*" --state Terminated --secret s3cr3t`,
+}
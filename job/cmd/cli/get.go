@@ -15,12 +15,15 @@
 package cli
 
 import (
+	"github.com/cybergarage/go-job/job"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	rootCmd.AddCommand(getCmd)
 	getCmd.AddCommand(getVersionCmd)
+	getCmd.AddCommand(getStatsCmd)
+	getStatsCmd.Flags().Duration("window", job.DefaultStatsWindow, "Window in which the completed and failed executions are counted")
 }
 
 var getCmd = &cobra.Command{ // nolint:exhaustruct
@@ -42,3 +45,17 @@ var getVersionCmd = &cobra.Command{ // nolint:exhaustruct
 		return nil
 	},
 }
+
+var getStatsCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "stats",
+	Short: "Get queue statistics",
+	Long:  "Get aggregate counts of job instances by kind and state, the queue depth, the worker utilization and the success and failure rates over a window.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		window, _ := cmd.Flags().GetDuration("window")
+		stats, err := GetClient().GetStats(job.WithStatsWindow(window))
+		if err != nil {
+			return err
+		}
+		return printStats(cmd, stats)
+	},
+}
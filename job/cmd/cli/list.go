@@ -23,6 +23,39 @@ func init() {
 	rootCmd.AddCommand(listCmd)
 	listCmd.AddCommand(listJobsCmd)
 	listCmd.AddCommand(listInstancesCmd)
+	listCmd.AddCommand(listAuditCmd)
+	listCmd.AddCommand(listSubscriptionsCmd)
+	for _, cmd := range []*cobra.Command{listInstancesCmd, listAuditCmd} {
+		cmd.Flags().Int("limit", 0, "Maximum number of results per page (0 means no limit)")
+		cmd.Flags().Int("offset", 0, "Number of results to skip")
+		cmd.Flags().String("order", job.SortAscending.String(), "Order of the results by their timestamps (asc or desc)")
+		cmd.Flags().String("page-token", "", "Page token of the next page printed with the previous page")
+	}
+}
+
+// newPageQuery returns the query of the page flags.
+func newPageQuery(cmd *cobra.Command) (job.Query, error) {
+	limit, _ := cmd.Flags().GetInt("limit")
+	offset, _ := cmd.Flags().GetInt("offset")
+	orderStr, _ := cmd.Flags().GetString("order")
+	order, err := job.NewSortOrderFromString(orderStr)
+	if err != nil {
+		return nil, err
+	}
+	opts := []job.QueryOption{
+		job.WithQueryLimit(limit),
+		job.WithQueryOffset(offset),
+		job.WithQueryOrder(order),
+	}
+	tokenStr, _ := cmd.Flags().GetString("page-token")
+	if 0 < len(tokenStr) {
+		token, err := job.NewPageTokenFromString(tokenStr)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, job.WithQueryPageToken(token))
+	}
+	return job.NewQuery(opts...), nil
 }
 
 var listCmd = &cobra.Command{ // nolint:exhaustruct
@@ -47,13 +80,52 @@ var listJobsCmd = &cobra.Command{ // nolint:exhaustruct
 var listInstancesCmd = &cobra.Command{ // nolint:exhaustruct
 	Use:   "instances",
 	Short: "List scheduled job instances",
-	Long:  "List all scheduled job instances.",
+	Long:  "List all scheduled job instances. With --limit, the instances are printed with the page token of the next page.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		query := job.NewQuery()
+		query, err := newPageQuery(cmd)
+		if err != nil {
+			return err
+		}
 		instances, err := GetClient().LookupInstances(query)
 		if err != nil {
 			return err
 		}
+		if _, ok := query.Limit(); ok {
+			return printInstancePage(cmd, query, instances)
+		}
 		return printInstances(cmd, instances)
 	},
 }
+
+var listAuditCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "audit",
+	Short: "List audit records",
+	Long:  "List all audit records of administrative operations. With --limit, the records are printed with the page token of the next page.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query, err := newPageQuery(cmd)
+		if err != nil {
+			return err
+		}
+		records, err := GetClient().LookupAuditRecords(query)
+		if err != nil {
+			return err
+		}
+		if _, ok := query.Limit(); ok {
+			return printAuditRecordPage(cmd, query, records)
+		}
+		return printAuditRecords(cmd, records)
+	},
+}
+
+var listSubscriptionsCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "subscriptions",
+	Short: "List webhook subscriptions",
+	Long:  "List all webhook subscriptions notified of the state changes of job instances.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		subs, err := GetClient().ListSubscriptions()
+		if err != nil {
+			return err
+		}
+		return printSubscriptions(cmd, subs)
+	},
+}
@@ -64,3 +64,86 @@ func printInstances(cmd *cobra.Command, instances []job.Instance) error {
 	cmd.Printf("]\n")
 	return nil
 }
+
+func printAuditRecords(cmd *cobra.Command, records []job.AuditRecord) error {
+	cmd.Printf("[\n")
+	for n, record := range records {
+		json, err := encoding.MapToJSON(record.Map())
+		if err != nil {
+			return err
+		}
+		cmd.Printf("  %s", json)
+		if n < len(records)-1 {
+			cmd.Printf(",\n")
+		} else {
+			cmd.Printf("\n")
+		}
+	}
+	cmd.Printf("]\n")
+	return nil
+}
+
+func printSubscription(cmd *cobra.Command, sub job.Subscription) error {
+	json, err := encoding.MapToJSON(sub.Map())
+	if err != nil {
+		return err
+	}
+	cmd.Println(json)
+	return nil
+}
+
+func printSubscriptions(cmd *cobra.Command, subs []job.Subscription) error {
+	cmd.Printf("[\n")
+	for n, sub := range subs {
+		json, err := encoding.MapToJSON(sub.Map())
+		if err != nil {
+			return err
+		}
+		cmd.Printf("  %s", json)
+		if n < len(subs)-1 {
+			cmd.Printf(",\n")
+		} else {
+			cmd.Printf("\n")
+		}
+	}
+	cmd.Printf("]\n")
+	return nil
+}
+
+func printStats(cmd *cobra.Command, stats job.Stats) error {
+	json, err := encoding.MapToJSON(stats.Map())
+	if err != nil {
+		return err
+	}
+	cmd.Println(json)
+	return nil
+}
+
+// printPage prints the results with the page token of the next page, which is empty if the results are the last page.
+func printPage[T interface{ Map() map[string]any }](cmd *cobra.Command, query job.Query, key string, results []T) error {
+	maps := make([]any, len(results))
+	for n, result := range results {
+		maps[n] = result.Map()
+	}
+	nextPageToken := ""
+	if token, ok := job.NextPageToken(query, results); ok {
+		nextPageToken = token.String()
+	}
+	json, err := encoding.MapToJSON(map[string]any{
+		key:               maps,
+		"next_page_token": nextPageToken,
+	})
+	if err != nil {
+		return err
+	}
+	cmd.Println(json)
+	return nil
+}
+
+func printInstancePage(cmd *cobra.Command, query job.Query, instances []job.Instance) error {
+	return printPage(cmd, query, "instances", instances)
+}
+
+func printAuditRecordPage(cmd *cobra.Command, query job.Query, records []job.AuditRecord) error {
+	return printPage(cmd, query, "records", records)
+}
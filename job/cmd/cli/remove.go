@@ -0,0 +1,50 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/cybergarage/go-job/job"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(removeCmd)
+	removeCmd.AddCommand(removeSubscriptionCmd)
+}
+
+var removeCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "remove",
+	Short: "Remove the specified resource",
+	Long:  "Remove the specified resource in the specified category.",
+}
+
+var removeSubscriptionCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "subscription id",
+	Short: "Remove a webhook subscription",
+	Long:  "Remove the webhook subscription with the specified identifier.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			cmd.Help()
+			return errInvalidArguments(args)
+		}
+
+		id, err := job.NewUUIDFrom(args[0])
+		if err != nil {
+			return err
+		}
+		return GetClient().RemoveSubscription(id)
+	},
+	Args: cobra.ExactArgs(1),
+}
@@ -23,6 +23,11 @@ import (
 
 var gRPCHost string
 var gRPCPort int
+var tlsEnabled bool
+var tlsCertFile string
+var tlsKeyFile string
+var tlsCAFile string
+var authToken string
 
 var rootCmd = &cobra.Command{ // nolint:exhaustruct
 	Use:               "jobctl",
@@ -30,16 +35,28 @@ var rootCmd = &cobra.Command{ // nolint:exhaustruct
 	Short:             "Job Control CLI",
 	Long:              "jobctl is a command-line interface for managing jobs in the go-job framework.",
 	DisableAutoGenTag: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return openClient()
+	},
 }
 
 func GetRootCommand() *cobra.Command {
 	return rootCmd
 }
 
-func Execute() error {
+// openClient opens a gRPC client with the host, port and TLS flags.
+func openClient() error {
+	tlsConfig := job.NewTLSConfig()
+	tlsConfig.SetTLSEnabled(tlsEnabled || 0 < len(tlsCAFile) || 0 < len(tlsCertFile))
+	tlsConfig.SetTLSCertFile(tlsCertFile)
+	tlsConfig.SetTLSKeyFile(tlsKeyFile)
+	tlsConfig.SetTLSCAFile(tlsCAFile)
+
 	client := job.NewClient()
 	client.SetHost(gRPCHost)
 	client.SetPort(gRPCPort)
+	client.SetTLSConfig(tlsConfig)
+	client.SetAuthToken(authToken)
 
 	if err := client.Open(); err != nil {
 		return err
@@ -47,7 +64,15 @@ func Execute() error {
 
 	SetClient(client)
 
+	return nil
+}
+
+func Execute() error {
 	defer func() {
+		client := GetClient()
+		if client == nil {
+			return
+		}
 		if err := client.Close(); err != nil {
 			fmt.Fprintf(rootCmd.OutOrStderr(), "%s\n", err)
 			return
@@ -60,4 +85,9 @@ func Execute() error {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&gRPCHost, "host", "localhost", fmt.Sprintf("gRPC host or address for a %v instance", job.ProductName))
 	rootCmd.PersistentFlags().IntVar(&gRPCPort, "port", job.DefaultGRPCPort, fmt.Sprintf("gRPC port number for a %v instance", job.ProductName))
+	rootCmd.PersistentFlags().BoolVar(&tlsEnabled, "tls", false, "use TLS to connect to the gRPC server")
+	rootCmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert", "", "client certificate file for mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key", "", "client private key file for mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&tlsCAFile, "tls-ca", "", "CA certificate file to verify the gRPC server")
+	rootCmd.PersistentFlags().StringVar(&authToken, "token", "", "bearer token to authenticate to the gRPC server")
 }
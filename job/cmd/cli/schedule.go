@@ -20,6 +20,8 @@ import (
 
 func init() {
 	rootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.Flags().BoolP("wait", "w", false, "Wait until the scheduled job instance reaches a final state and print its results")
+	scheduleCmd.Flags().Duration("timeout", 0, "Maximum duration to wait for the job instance (0 means no timeout)")
 }
 
 var scheduleCmd = &cobra.Command{ // nolint:exhaustruct
@@ -43,8 +45,15 @@ var scheduleCmd = &cobra.Command{ // nolint:exhaustruct
 			return err
 		}
 
-		return printInstance(cmd, job)
+		wait, _ := cmd.Flags().GetBool("wait")
+		if !wait {
+			return printInstance(cmd, job)
+		}
+
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		return waitInstance(cmd, job.UUID(), timeout)
 	},
-	Args:    cobra.MinimumNArgs(1), // Ensure at least one argument is provided
-	Example: `job schedule kind arg1 arg2`,
+	Args: cobra.MinimumNArgs(1), // Ensure at least one argument is provided
+	Example: `job schedule kind arg1 arg2
+job schedule --wait --timeout 30s kind arg1 arg2`,
 }
@@ -0,0 +1,65 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(waitCmd)
+	waitCmd.AddCommand(waitInstanceCmd)
+	waitInstanceCmd.Flags().Duration("timeout", 0, "Maximum duration to wait for the job instance (0 means no timeout)")
+}
+
+var waitCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "wait",
+	Short: "Wait for the specified resource",
+	Long:  "Wait for the specified resource to reach a final state.",
+}
+
+var waitInstanceCmd = &cobra.Command{ // nolint:exhaustruct
+	Use:   "instance uuid",
+	Short: "Wait for a job instance",
+	Long:  "Wait until the specified job instance reaches a final state, and print the instance including its results or error.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		uuid, err := job.NewUUIDFrom(args[0])
+		if err != nil {
+			return err
+		}
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		return waitInstance(cmd, uuid, timeout)
+	},
+	Args:    cobra.ExactArgs(1),
+	Example: `job wait instance 0199a2c4-1b7e-7c1e-9b4a-3f2d8e6a5c10`,
+}
+
+func waitInstance(cmd *cobra.Command, uuid job.UUID, timeout time.Duration) error {
+	ctx := context.Background()
+	if 0 < timeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	instance, err := GetClient().WaitInstance(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	return printInstance(cmd, instance)
+}
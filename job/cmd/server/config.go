@@ -0,0 +1,444 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/job/command"
+	"github.com/cybergarage/go-job/job/plugins/job/system"
+	"github.com/cybergarage/go-job/job/plugins/store"
+	"github.com/cybergarage/go-job/job/plugins/store/kv/etcd"
+	"github.com/cybergarage/go-job/job/plugins/store/kv/redis"
+	"github.com/cybergarage/go-job/job/plugins/store/kv/valkey"
+	"github.com/cybergarage/go-logger/log"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Configuration keys. The environment variables are the upper case keys with the GO_JOB_ prefix, and the dots replaced by underscores (e.g., GO_JOB_SERVER_GRPC_PORT).
+const (
+	ServerBindAddrKey       = "server.bind_addr"
+	ServerGRPCPortKey       = "server.grpc_port"
+	ServerPrometheusPortKey = "server.prometheus_port"
+	ServerHTTPPortKey       = "server.http_port"
+	ServerDrainTimeoutKey   = "server.drain_timeout"
+	TLSEnabledKey           = "server.tls.enabled"
+	TLSCertFileKey          = "server.tls.cert_file"
+	TLSKeyFileKey           = "server.tls.key_file"
+	TLSCAFileKey            = "server.tls.ca_file"
+	TLSClientAuthKey        = "server.tls.client_auth"
+	LogLevelKey             = "log.level"
+	WorkerNumKey            = "worker.num"
+	StorePluginKey          = "store.plugin"
+	StoreEtcdEndpointsKey   = "store.etcd.endpoints"
+	StoreRedisAddrKey       = "store.redis.addr"
+	StoreValkeyAddrKey      = "store.valkey.addr"
+	TracingExporterKey      = "tracing.exporter"
+	TracingOTLPEndpointKey  = "tracing.otlp.endpoint"
+	TracingOTLPInsecureKey  = "tracing.otlp.insecure"
+	HistoryCleanerKey       = "jobs.history_cleaner"
+	LogCleanerKey           = "jobs.log_cleaner"
+	AuditCleanerKey         = "jobs.audit_cleaner"
+	CommandJobsKey          = "jobs.commands"
+	scheduleKey             = "schedule"
+	retentionKey            = "retention"
+)
+
+// Store plugin names.
+const (
+	StoreLocal  = "local"
+	StoreMemdb  = "memdb"
+	StoreEtcd   = "etcd"
+	StoreRedis  = "redis"
+	StoreValkey = "valkey"
+)
+
+// Trace exporter names.
+const (
+	TracingNone   = "none"
+	TracingStdout = "stdout"
+	TracingOTLP   = "otlp"
+)
+
+const (
+	// DefaultOTLPEndpoint is the default endpoint of the OTLP gRPC trace exporter.
+	DefaultOTLPEndpoint = "127.0.0.1:4317"
+	// DefaultConfigDir is the system configuration directory searched for the configuration file.
+	DefaultConfigDir = "/etc/" + job.ProductName
+	// DefaultLogLevel is the default log level.
+	DefaultLogLevel = "info"
+	// DefaultRetention is the default retention period of the system cleaner jobs.
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultDrainTimeout is the default timeout of draining the server before terminating. The server is not drained if the timeout is 0.
+	DefaultDrainTimeout = time.Duration(0)
+)
+
+// Config represents a configuration interface.
+type Config interface {
+	// Load reads the specified configuration file. If the file is empty, the configuration file is searched in the current and system configuration directories,
+	// and only the default values and the environment variables are used if no configuration file is found.
+	Load(file string) error
+	// UsedConfigFile returns the configuration file used.
+	UsedConfigFile() string
+	// LogLevel returns the configured log level.
+	LogLevel() log.Level
+	// DrainTimeout returns the configured timeout of draining the server before terminating.
+	DrainTimeout() time.Duration
+	// NewServer returns a new job server configured by the configuration.
+	NewServer() (job.Server, error)
+	// ReloadServer applies the configuration to the specified running server, and registers and schedules the configured jobs again.
+	// The store and the job manager of the server are kept, so that the store settings are not applied until the server is restarted.
+	ReloadServer(server job.Server) error
+	// NewTracerProvider returns a new tracer provider of the configured trace exporter, or nil if no exporter is configured.
+	NewTracerProvider() (*sdktrace.TracerProvider, error)
+	// RegisterJobs registers the configured command jobs with the specified manager, and schedules the jobs which have crontab schedules.
+	// The registered jobs of the same kinds are replaced, and the jobs are not scheduled again if their recurring instances are already queued.
+	RegisterJobs(mgr job.Manager) error
+	// ScheduleSystemJobs schedules the configured system jobs with the specified manager.
+	// The system jobs are not scheduled again if their recurring instances are already queued.
+	ScheduleSystemJobs(mgr job.Manager) error
+	// String returns a string representation of the configuration.
+	String() string
+}
+
+// commandJobConfig represents a command job entry of the configuration.
+type commandJobConfig struct {
+	Kind        string        `mapstructure:"kind"`
+	Description string        `mapstructure:"description"`
+	Command     []string      `mapstructure:"command"`
+	Dir         string        `mapstructure:"dir"`
+	Env         []string      `mapstructure:"env"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+	MaxRetries  int           `mapstructure:"max_retries"`
+	Schedule    string        `mapstructure:"schedule"`
+}
+
+type viperConfig struct {
+	*viper.Viper
+}
+
+// NewConfig creates a new configuration with the default values.
+func NewConfig() Config {
+	v := viper.New()
+	v.SetConfigName(job.ProductName)
+	v.SetConfigType("yaml")
+	v.SetEnvPrefix(strings.ReplaceAll(strings.ToUpper(job.ProductName), "-", "_"))
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	v.SetDefault(ServerBindAddrKey, job.DefaultBindAddr)
+	v.SetDefault(ServerGRPCPortKey, job.DefaultGRPCPort)
+	v.SetDefault(ServerPrometheusPortKey, job.DefaultPrometheusPort)
+	v.SetDefault(ServerHTTPPortKey, job.DefaultHTTPPort)
+	v.SetDefault(ServerDrainTimeoutKey, DefaultDrainTimeout)
+	v.SetDefault(TLSEnabledKey, false)
+	v.SetDefault(TLSCertFileKey, "")
+	v.SetDefault(TLSKeyFileKey, "")
+	v.SetDefault(TLSCAFileKey, "")
+	v.SetDefault(TLSClientAuthKey, false)
+	v.SetDefault(LogLevelKey, DefaultLogLevel)
+	v.SetDefault(WorkerNumKey, job.DefaultWorkerNum)
+	v.SetDefault(StorePluginKey, StoreLocal)
+	v.SetDefault(StoreEtcdEndpointsKey, []string{net.JoinHostPort(etcd.DefaultHost, etcd.DefaultPort)})
+	v.SetDefault(StoreRedisAddrKey, net.JoinHostPort(redis.DefaultHost, redis.DefaultPort))
+	v.SetDefault(StoreValkeyAddrKey, net.JoinHostPort(valkey.DefaultHost, valkey.DefaultPort))
+	v.SetDefault(TracingExporterKey, TracingNone)
+	v.SetDefault(TracingOTLPEndpointKey, DefaultOTLPEndpoint)
+	v.SetDefault(TracingOTLPInsecureKey, false)
+	for _, key := range []string{HistoryCleanerKey, LogCleanerKey, AuditCleanerKey} {
+		v.SetDefault(key+"."+scheduleKey, "")
+		v.SetDefault(key+"."+retentionKey, DefaultRetention)
+	}
+
+	return &viperConfig{
+		Viper: v,
+	}
+}
+
+// Load reads the specified configuration file. If the file is empty, the configuration file is searched in the current and system configuration directories,
+// and only the default values and the environment variables are used if no configuration file is found.
+func (conf *viperConfig) Load(file string) error {
+	if 0 < len(file) {
+		conf.SetConfigFile(file)
+		return conf.ReadInConfig()
+	}
+	conf.AddConfigPath(".")
+	conf.AddConfigPath(DefaultConfigDir)
+	err := conf.ReadInConfig()
+	var notFoundErr viper.ConfigFileNotFoundError
+	if errors.As(err, &notFoundErr) {
+		return nil
+	}
+	return err
+}
+
+// UsedConfigFile returns the configuration file used.
+func (conf *viperConfig) UsedConfigFile() string {
+	return conf.ConfigFileUsed()
+}
+
+// LogLevel returns the configured log level.
+func (conf *viperConfig) LogLevel() log.Level {
+	return log.GetLevelFromString(conf.GetString(LogLevelKey))
+}
+
+// DrainTimeout returns the configured timeout of draining the server before terminating.
+func (conf *viperConfig) DrainTimeout() time.Duration {
+	return conf.GetDuration(ServerDrainTimeoutKey)
+}
+
+// NewServer returns a new job server configured by the configuration.
+func (conf *viperConfig) NewServer() (job.Server, error) {
+	store, err := conf.newStore()
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := job.NewServer(
+		job.WithStore(store),
+		job.WithNumWorkers(conf.GetInt(WorkerNumKey)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	conf.configureServer(server)
+
+	return server, nil
+}
+
+// configureServer applies the server settings of the configuration, which can be changed without restarting the job manager.
+func (conf *viperConfig) configureServer(server job.Server) {
+	server.SetBindAddr(conf.GetString(ServerBindAddrKey))
+	server.SetGRPCPort(conf.GetInt(ServerGRPCPortKey))
+	server.SetPrometheusPort(conf.GetInt(ServerPrometheusPortKey))
+	server.SetHTTPPort(conf.GetInt(ServerHTTPPortKey))
+	server.SetTLSEnabled(conf.GetBool(TLSEnabledKey))
+	server.SetTLSCertFile(conf.GetString(TLSCertFileKey))
+	server.SetTLSKeyFile(conf.GetString(TLSKeyFileKey))
+	server.SetTLSCAFile(conf.GetString(TLSCAFileKey))
+	server.SetTLSClientAuth(conf.GetBool(TLSClientAuthKey))
+}
+
+// ReloadServer applies the configuration to the specified running server, and registers and schedules the configured jobs again.
+// The store and the job manager of the server are kept, so that the store settings are not applied until the server is restarted.
+func (conf *viperConfig) ReloadServer(server job.Server) error {
+	mgr := server.Manager()
+	if plugin := conf.GetString(StorePluginKey); plugin != mgr.Store().Name() {
+		log.Warnf("store plugin %q is not applied until %s is restarted", plugin, job.ProductName)
+	}
+	conf.configureServer(server)
+	if err := server.Reload(); err != nil {
+		return err
+	}
+	if err := mgr.ResizeWorkers(context.Background(), conf.GetInt(WorkerNumKey)); err != nil {
+		return err
+	}
+	if err := conf.RegisterJobs(mgr); err != nil {
+		return err
+	}
+	return conf.ScheduleSystemJobs(mgr)
+}
+
+// newStore returns a new store of the configured store plugin.
+func (conf *viperConfig) newStore() (job.Store, error) {
+	plugin := conf.GetString(StorePluginKey)
+	switch plugin {
+	case StoreLocal:
+		return job.NewLocalStore(), nil
+	case StoreMemdb:
+		return store.NewMemdbStore(), nil
+	case StoreEtcd:
+		option := etcd.NewStoreOption()
+		option.Endpoints = conf.GetStringSlice(StoreEtcdEndpointsKey)
+		return store.NewEtcdStore(option), nil
+	case StoreRedis:
+		option := redis.NewStoreOption()
+		option.Addr = conf.GetString(StoreRedisAddrKey)
+		return store.NewRedisStore(option), nil
+	case StoreValkey:
+		option := valkey.NewStoreOption()
+		option.InitAddress = []string{conf.GetString(StoreValkeyAddrKey)}
+		return store.NewValkeyStore(option), nil
+	}
+	return nil, fmt.Errorf("store plugin %q is %w", plugin, job.ErrInvalid)
+}
+
+// NewTracerProvider returns a new tracer provider of the configured trace exporter, or nil if no exporter is configured.
+func (conf *viperConfig) NewTracerProvider() (*sdktrace.TracerProvider, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	exporterName := conf.GetString(TracingExporterKey)
+	switch exporterName {
+	case TracingNone, "":
+		return nil, nil
+	case TracingStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case TracingOTLP:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(conf.GetString(TracingOTLPEndpointKey)),
+		}
+		if conf.GetBool(TracingOTLPInsecureKey) {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("trace exporter %q is %w", exporterName, job.ErrInvalid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", job.ProductName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// RegisterJobs registers the configured command jobs with the specified manager, and schedules the jobs which have crontab schedules.
+func (conf *viperConfig) RegisterJobs(mgr job.Manager) error {
+	var jobConfigs []commandJobConfig
+	if err := conf.UnmarshalKey(CommandJobsKey, &jobConfigs); err != nil {
+		return fmt.Errorf("%s: %w", CommandJobsKey, err)
+	}
+	for n, jobConfig := range jobConfigs {
+		if len(jobConfig.Kind) == 0 {
+			return fmt.Errorf("%s[%d]: kind is %w", CommandJobsKey, n, job.ErrInvalid)
+		}
+		opts := []any{
+			job.WithDescription(jobConfig.Description),
+			job.WithTimeout(jobConfig.Timeout),
+			job.WithMaxRetries(jobConfig.MaxRetries),
+			command.WithDir(jobConfig.Dir),
+			command.WithEnv(jobConfig.Env...),
+		}
+		j, err := command.NewCommandJob(jobConfig.Kind, jobConfig.Command, opts...)
+		if err != nil {
+			return fmt.Errorf("%s[%d]: %w", CommandJobsKey, n, err)
+		}
+		if err := registerJob(mgr, j); err != nil {
+			return fmt.Errorf("%s[%d]: %w", CommandJobsKey, n, err)
+		}
+		if len(jobConfig.Schedule) == 0 {
+			continue
+		}
+		if err := scheduleRecurringJob(mgr, j, jobConfig.Schedule); err != nil {
+			return fmt.Errorf("%s[%d]: %w", CommandJobsKey, n, err)
+		}
+	}
+	return nil
+}
+
+// ScheduleSystemJobs schedules the configured system jobs with the specified manager.
+// The system jobs are scheduled only if their crontab schedules are set.
+func (conf *viperConfig) ScheduleSystemJobs(mgr job.Manager) error {
+	cleaners := []struct {
+		key        string
+		newCleaner func(opts ...system.CleanerOption) job.Job
+	}{
+		{HistoryCleanerKey, system.NewHistoryCleaner},
+		{LogCleanerKey, system.NewLogCleaner},
+		{AuditCleanerKey, system.NewAuditCleaner},
+	}
+	for _, cleaner := range cleaners {
+		spec := conf.GetString(cleaner.key + "." + scheduleKey)
+		if len(spec) == 0 {
+			continue
+		}
+		retention := conf.GetDuration(cleaner.key + "." + retentionKey)
+		j := cleaner.newCleaner(system.WithRetention(retention))
+		if err := registerJob(mgr, j); err != nil {
+			return fmt.Errorf("%s: %w", cleaner.key, err)
+		}
+		if err := scheduleRecurringJob(mgr, j, spec); err != nil {
+			return fmt.Errorf("%s: %w", cleaner.key, err)
+		}
+	}
+	return nil
+}
+
+// registerJob registers the job with the specified manager, and replaces the registered job of the same kind.
+func registerJob(mgr job.Manager, j job.Job) error {
+	if _, ok := mgr.LookupJob(j.Kind()); ok {
+		if err := mgr.UnregisterJob(j.Kind()); err != nil {
+			return err
+		}
+	}
+	return mgr.RegisterJob(j)
+}
+
+// scheduleRecurringJob schedules the job with the crontab spec unless a recurring instance of the job with the spec is already queued or processing,
+// so that the recurring instances are not duplicated whenever the server is started or reloaded with a persistent store.
+// The queued recurring instances of the job with other specs are canceled.
+func scheduleRecurringJob(mgr job.Manager, j job.Job, spec string) error {
+	instances, err := mgr.Store().ListInstances(context.Background())
+	if err != nil {
+		return err
+	}
+	scheduled := false
+	for _, ji := range instances {
+		if ji.Kind() != j.Kind() || !ji.IsRecurring() {
+			continue
+		}
+		if ji.CrontabSpec() == spec {
+			scheduled = true
+			continue
+		}
+		if _, err := mgr.CancelInstances(job.NewQuery(job.WithQueryUUID(ji.UUID()))); err != nil {
+			return err
+		}
+	}
+	for _, worker := range mgr.Workers() {
+		ji, ok := worker.ProcessingInstance()
+		if ok && ji.Kind() == j.Kind() && ji.IsRecurring() && ji.CrontabSpec() == spec {
+			scheduled = true
+		}
+	}
+	if scheduled {
+		return nil
+	}
+	_, err = mgr.ScheduleJob(j, job.WithCrontabSpec(spec))
+	return err
+}
+
+// String returns a string representation of the configuration.
+func (conf *viperConfig) String() string {
+	var s string
+	keys := conf.AllKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := conf.Get(key)
+		s += fmt.Sprintf("%s: %v\n", key, value)
+	}
+	return strings.TrimSuffix(s, "\n")
+}
@@ -15,25 +15,37 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/cybergarage/go-job/job"
 	"github.com/cybergarage/go-logger/log"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 var cfgFile string
 
+var tracerProvider *sdktrace.TracerProvider
+
 var rootCmd = &cobra.Command{ // nolint:exhaustruct
 	Use:               "jobd",
 	Version:           job.Version,
 	Short:             "",
 	Long:              "",
 	DisableAutoGenTag: true,
+	SilenceUsage:      true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return run()
+	},
 }
 
 var versionCmd = &cobra.Command{ // nolint:exhaustruct
@@ -51,17 +63,120 @@ func GetRootCommand() *cobra.Command {
 }
 
 func Execute() {
-	log.SetSharedLogger(log.NewStdoutLogger(log.LevelInfo))
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
 
-	server, err := job.NewServer()
+// loadConfig loads the configuration file and the environment variables, and applies the log level.
+func loadConfig() (Config, error) {
+	conf := NewConfig()
+	if err := conf.Load(cfgFile); err != nil {
+		return nil, err
+	}
+	log.SetSharedLogger(log.NewStdoutLogger(conf.LogLevel()))
+	if file := conf.UsedConfigFile(); 0 < len(file) {
+		log.Infof("config file: %s", file)
+	}
+	return conf, nil
+}
+
+// startTracing sets the tracer provider of the configured trace exporter as the global tracer provider which traces the job instances,
+// and shuts down the previous tracer provider.
+func startTracing(conf Config) error {
+	tp, err := conf.NewTracerProvider()
 	if err != nil {
-		log.Errorf("%s couldn't be created (%s)", job.ProductName, err.Error())
-		os.Exit(1)
+		return err
+	}
+	stopTracing()
+	if tp != nil {
+		otel.SetTracerProvider(tp)
+	} else {
+		otel.SetTracerProvider(noop.NewTracerProvider())
 	}
+	tracerProvider = tp
+	return nil
+}
+
+// stopTracing flushes the spans of the global tracer provider, and shuts down the provider.
+func stopTracing() {
+	if tracerProvider == nil {
+		return
+	}
+	if err := tracerProvider.Shutdown(context.Background()); err != nil {
+		log.Error(err)
+	}
+	tracerProvider = nil
+}
 
+// startServer creates and starts a new job server with the configuration, and schedules the configured system jobs.
+func startServer(conf Config) (job.Server, error) {
+	if err := startTracing(conf); err != nil {
+		return nil, fmt.Errorf("%s tracing couldn't be started (%w)", job.ProductName, err)
+	}
+	server, err := conf.NewServer()
+	if err != nil {
+		return nil, fmt.Errorf("%s couldn't be created (%w)", job.ProductName, err)
+	}
 	if err := server.Start(); err != nil {
-		log.Errorf("%s couldn't be started (%s)", job.ProductName, err.Error())
-		os.Exit(1)
+		server.Stop() // nolint:errcheck
+		return nil, fmt.Errorf("%s couldn't be started (%w)", job.ProductName, err)
+	}
+	if err := conf.RegisterJobs(server.Manager()); err != nil {
+		server.Stop() // nolint:errcheck
+		return nil, fmt.Errorf("%s jobs couldn't be registered (%w)", job.ProductName, err)
+	}
+	if err := conf.ScheduleSystemJobs(server.Manager()); err != nil {
+		server.Stop() // nolint:errcheck
+		return nil, fmt.Errorf("%s system jobs couldn't be scheduled (%w)", job.ProductName, err)
+	}
+	return server, nil
+}
+
+// reloadServer reloads the configuration, and applies it to the running server, which keeps its store and job manager across reloads.
+// If the new configuration couldn't be applied, the current configuration is applied again, or no server is returned if it couldn't be applied either.
+func reloadServer(server job.Server, conf Config) (job.Server, Config, error) {
+	newConf, err := loadConfig()
+	if err != nil {
+		return server, conf, err
+	}
+	if err := startTracing(newConf); err != nil {
+		return server, conf, err
+	}
+	if err := newConf.ReloadServer(server); err != nil {
+		if restoreErr := errors.Join(startTracing(conf), conf.ReloadServer(server)); restoreErr != nil {
+			return nil, conf, errors.Join(err, restoreErr)
+		}
+		return server, conf, err
+	}
+	return server, newConf, nil
+}
+
+// drainServer drains the server until the workers finish processing the current job instances or the timeout expires, so that load balancers stop routing new requests to the server before terminating.
+// The server is not drained if the timeout is 0.
+func drainServer(server job.Server, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	log.Infof("draining %s (timeout: %s)...", job.ProductName, timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := server.Drain(ctx); err != nil {
+		log.Warnf("%s couldn't be drained (%s)", job.ProductName, err.Error())
+	}
+}
+
+func run() error {
+	log.SetSharedLogger(log.NewStdoutLogger(log.LevelInfo))
+
+	conf, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	server, err := startServer(conf)
+	if err != nil {
+		return err
 	}
 
 	sigCh := make(chan os.Signal, 1)
@@ -72,35 +187,32 @@ func Execute() {
 		syscall.SIGINT,
 		syscall.SIGTERM)
 
-	exitCh := make(chan int)
-
-	go func() {
-		for {
-			s := <-sigCh
-			switch s {
-			case syscall.SIGHUP:
-				log.Infof("caught %s, restarting...", s.String())
-				if err := server.Restart(); err != nil {
-					log.Errorf("%s couldn't be restarted (%s)", job.ProductName, err.Error())
-					os.Exit(1)
-				}
-			case syscall.SIGINT, syscall.SIGTERM:
-				log.Infof("caught %s, terminating...", s.String())
-				if err := server.Stop(); err != nil {
-					log.Errorf("%s couldn't be terminated (%s)", job.ProductName, err.Error())
-					os.Exit(1)
-				}
-				exitCh <- 0
+	for s := range sigCh {
+		switch s {
+		case syscall.SIGHUP:
+			log.Infof("caught %s, reloading...", s.String())
+			server, conf, err = reloadServer(server, conf)
+			if server == nil {
+				return fmt.Errorf("%s couldn't be reloaded (%w)", job.ProductName, err)
+			}
+			if err != nil {
+				log.Errorf("%s couldn't be reloaded (%s)", job.ProductName, err.Error())
+			}
+		case syscall.SIGINT, syscall.SIGTERM:
+			log.Infof("caught %s, terminating...", s.String())
+			defer stopTracing()
+			drainServer(server, conf.DrainTimeout())
+			if err := server.Stop(); err != nil {
+				return fmt.Errorf("%s couldn't be terminated (%w)", job.ProductName, err)
 			}
+			return nil
 		}
-	}()
-
-	code := <-exitCh
+	}
 
-	os.Exit(code)
+	return nil
 }
 
 func init() {
 	rootCmd.AddCommand(versionCmd)
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ./job.yaml)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ./"+job.ProductName+".yaml or "+DefaultConfigDir+"/"+job.ProductName+".yaml)")
 }
@@ -16,6 +16,12 @@ package job
 
 // Config is the interface for the job server configuration.
 type Config interface {
+	// TLSConfig is the interface for the TLS configuration of the gRPC server.
+	TLSConfig
+	// SetBindAddr sets the bind address of the gRPC, Prometheus and HTTP servers for the job server.
+	SetBindAddr(addr string)
+	// BindAddr returns the bind address of the gRPC, Prometheus and HTTP servers for the job server.
+	BindAddr() string
 	// SetGRPCPort sets the gRPC port for the job server.
 	SetGRPCPort(port int)
 	// GRPCPort returns the gRPC port for the job server.
@@ -24,21 +30,42 @@ type Config interface {
 	SetPrometheusPort(port int)
 	// PrometheusPort returns the Prometheus port for the job server.
 	PrometheusPort() int
+	// SetHTTPPort sets the HTTP port of the HTTP/JSON gateway for the job server.
+	// The gateway is disabled if the port is 0, and is served by the Prometheus server if the port is the same as the Prometheus port.
+	SetHTTPPort(port int)
+	// HTTPPort returns the HTTP port of the HTTP/JSON gateway for the job server.
+	HTTPPort() int
 }
 
 type config struct {
+	*tlsConfig
+	bindAddr       string
 	grpcPort       int
 	prometheusPort int
+	httpPort       int
 }
 
 // newConfig creates a new Config with default values.
 func newConfig() *config {
 	return &config{
+		tlsConfig:      newTLSConfig(),
+		bindAddr:       DefaultBindAddr,
 		grpcPort:       DefaultGRPCPort,
 		prometheusPort: DefaultPrometheusPort,
+		httpPort:       DefaultHTTPPort,
 	}
 }
 
+// SetBindAddr sets the bind address of the gRPC, Prometheus and HTTP servers for the job server.
+func (config *config) SetBindAddr(addr string) {
+	config.bindAddr = addr
+}
+
+// BindAddr returns the bind address of the gRPC, Prometheus and HTTP servers for the job server.
+func (config *config) BindAddr() string {
+	return config.bindAddr
+}
+
 // SetGRPCPort sets the gRPC port for the job server.
 func (config *config) SetGRPCPort(port int) {
 	config.grpcPort = port
@@ -58,3 +85,14 @@ func (config *config) GRPCPort() int {
 func (config *config) PrometheusPort() int {
 	return config.prometheusPort
 }
+
+// SetHTTPPort sets the HTTP port of the HTTP/JSON gateway for the job server.
+// The gateway is disabled if the port is 0, and is served by the Prometheus server if the port is the same as the Prometheus port.
+func (config *config) SetHTTPPort(port int) {
+	config.httpPort = port
+}
+
+// HTTPPort returns the HTTP port of the HTTP/JSON gateway for the job server.
+func (config *config) HTTPPort() int {
+	return config.httpPort
+}
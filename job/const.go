@@ -20,12 +20,14 @@ const (
 )
 
 const (
-	// DefaultBindAddr is the default address of the gRPC and Prometheus server.
+	// DefaultBindAddr is the default address of the gRPC, Prometheus and HTTP server.
 	DefaultBindAddr = ""
 	// DefaultGRPCPort is the default gRPC port for the job server.
 	DefaultGRPCPort = 59051
 	// DefaultPrometheusPort is the default Prometheus port for the job server.
 	DefaultPrometheusPort = 9090
+	// DefaultHTTPPort is the default HTTP port of the HTTP/JSON gateway for the job server. The gateway is disabled by default.
+	DefaultHTTPPort = 0
 	// DefaultAPIVersion is the default API version.
 	DefaultAPIVersion = "v1"
 )
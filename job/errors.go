@@ -32,3 +32,24 @@ var ErrNil = errors.New("nil")
 
 // ErrNotProcessing is a not processing error.
 var ErrNotProcessing = errors.New("not processing")
+
+// ErrCanceled is a canceled error.
+var ErrCanceled = errors.New("canceled")
+
+// ErrTimedOut is a timed out error.
+var ErrTimedOut = errors.New("timed out")
+
+// ErrPanicked is a panicked error.
+var ErrPanicked = errors.New("panicked")
+
+// ErrUnauthenticated is an unauthenticated error.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// ErrPermissionDenied is a permission denied error.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ErrNotReady is a not ready error.
+var ErrNotReady = errors.New("not ready")
+
+// ErrDraining is a draining error.
+var ErrDraining = errors.New("draining")
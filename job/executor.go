@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/cybergarage/go-safecast/safecast"
 )
@@ -26,10 +27,42 @@ import (
 // Placeholder is a placeholder for the job arguments.
 var Placeholder string = "?"
 
+// argumentTag is the struct tag key used to bind named arguments to struct fields.
+const argumentTag = "job"
+
 // Executor is a type that represents a function that executes a job.
 // It can be any function type, allowing for flexible job execution.
 type Executor any
 
+// ArgumentNames represents the names of the executor parameters, which are used to bind named arguments.
+type ArgumentNames []string
+
+// structFieldName returns the named argument key of the struct field, which is the `job` tag name if set, or the field name otherwise.
+func structFieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup(argumentTag); ok {
+		name, _, _ := strings.Cut(tag, ",")
+		if 0 < len(name) {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// structFieldByName returns the struct field which matches the specified field name or `job` tag name.
+func structFieldByName(structValue reflect.Value, name string) reflect.Value {
+	field := structValue.FieldByName(name)
+	if field.IsValid() {
+		return field
+	}
+	structType := structValue.Type()
+	for i := range structType.NumField() {
+		if structFieldName(structType.Field(i)) == name {
+			return structValue.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
 // Execute calls the given function with the provided parameters and returns results as []any.
 func Execute(fn any, args []any, opts ...any) (ResultSet, error) {
 	fnObj := reflect.ValueOf(fn)
@@ -44,9 +77,15 @@ func Execute(fn any, args []any, opts ...any) (ResultSet, error) {
 	var instance Instance
 	var worker Worker
 	var ctx context.Context
+	var namedArgs NamedArguments
+	var argNames ArgumentNames
 
 	for _, opt := range opts {
 		switch v := opt.(type) {
+		case NamedArguments:
+			namedArgs = v
+		case ArgumentNames:
+			argNames = v
 		case Manager:
 			manager = v
 		case Instance:
@@ -112,6 +151,82 @@ func Execute(fn any, args []any, opts ...any) (ResultSet, error) {
 		return prepArgs, nil
 	}
 
+	isPlaceholder := func(arg any) bool {
+		s, ok := arg.(string)
+		return ok && s == Placeholder
+	}
+
+	// bindNamedArguments binds the named arguments to the parameters by the argument names or the struct fields,
+	// and the remaining parameters are bound to the positional arguments in order.
+	bindNamedArguments := func(fnType reflect.Type, args []any) ([]any, error) {
+		boundArgs := make([]any, fnType.NumIn())
+		boundNames := map[string]bool{}
+		argIdx := 0
+		nameIdx := 0
+		for n := range fnType.NumIn() {
+			fnArgType := fnType.In(n)
+			if _, ok := spArgs[fnArgType]; ok {
+				if argIdx < len(args) && isPlaceholder(args[argIdx]) {
+					argIdx++
+				}
+				boundArgs[n] = Placeholder
+				continue
+			}
+
+			name := ""
+			if nameIdx < len(argNames) {
+				name = argNames[nameIdx]
+			}
+			nameIdx++
+			if arg, ok := namedArgs[name]; ok && 0 < len(name) {
+				boundArgs[n] = arg
+				boundNames[name] = true
+				continue
+			}
+
+			if argIdx < len(args) {
+				boundArgs[n] = args[argIdx]
+				argIdx++
+				continue
+			}
+
+			structType := fnArgType
+			if structType.Kind() == reflect.Ptr {
+				structType = structType.Elem()
+			}
+			if structType.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("argument[%d] is missing: want %v", n, fnArgType)
+			}
+			fieldArgs := map[string]any{}
+			for i := range structType.NumField() {
+				field := structType.Field(i)
+				if !field.IsExported() {
+					continue
+				}
+				fieldName := structFieldName(field)
+				arg, ok := namedArgs[fieldName]
+				if !ok {
+					continue
+				}
+				fieldArgs[field.Name] = arg
+				boundNames[fieldName] = true
+			}
+			if len(fieldArgs) == 0 {
+				return nil, fmt.Errorf("argument[%d] is missing: want %v", n, fnArgType)
+			}
+			boundArgs[n] = fieldArgs
+		}
+		if argIdx < len(args) {
+			return nil, fmt.Errorf("argument count mismatch: want %d, got %d", argIdx, len(args))
+		}
+		for name := range namedArgs {
+			if !boundNames[name] {
+				return nil, fmt.Errorf("unknown named argument: %s", name)
+			}
+		}
+		return boundArgs, nil
+	}
+
 	assignTo := func(arg any, fnArgType reflect.Type) (reflect.Value, bool) {
 		assignMapTo := func(arg any, fnType reflect.Type) (reflect.Value, bool) {
 			var argMap map[string]any
@@ -133,7 +248,7 @@ func Execute(fn any, args []any, opts ...any) (ResultSet, error) {
 
 			structValue := reflect.New(fnType).Elem()
 			for mapKey, mapValue := range argMap {
-				field := structValue.FieldByName(mapKey)
+				field := structFieldByName(structValue, mapKey)
 				if !field.IsValid() {
 					return reflect.Value{}, false // Invalid field name
 				}
@@ -190,6 +305,14 @@ func Execute(fn any, args []any, opts ...any) (ResultSet, error) {
 
 	// execution (main function)
 
+	if 0 < len(namedArgs) {
+		var err error
+		args, err = bindNamedArguments(fnType, args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	args, err := prepareArguments(fnType, args)
 	if err != nil {
 		return nil, err
@@ -90,8 +90,9 @@ func (f *filter) IsUnset() bool {
 	if f == nil {
 		return true
 	}
-	_, ok := f.Before()
-	return !ok
+	_, hasBefore := f.Before()
+	_, hasAfter := f.After()
+	return !hasBefore && !hasAfter
 }
 
 // Matches checks if the specified object matches the filter criteria.
@@ -124,6 +125,14 @@ func (f *filter) Matches(v any) bool {
 			return false
 		}
 		return true
+	case AuditRecord:
+		if before, ok := f.Before(); ok && !v.Timestamp().Before(before) {
+			return false
+		}
+		if after, ok := f.After(); ok && !v.Timestamp().After(after) {
+			return false
+		}
+		return true
 	}
 	return false
 }
@@ -15,7 +15,15 @@
 package job
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
 	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newQueryFromGrpcQuery(query *v1.Query) (Query, error) {
@@ -40,15 +48,62 @@ func newQueryFromGrpcQuery(query *v1.Query) (Query, error) {
 		}
 		queryOpts = append(queryOpts, WithQueryState(state))
 	}
+	if query.Limit != nil {
+		if query.GetLimit() < 0 {
+			return nil, fmt.Errorf("limit (%d) is %w", query.GetLimit(), ErrInvalid)
+		}
+		queryOpts = append(queryOpts, WithQueryLimit(int(query.GetLimit())))
+	}
+	if query.Offset != nil {
+		if query.GetOffset() < 0 {
+			return nil, fmt.Errorf("offset (%d) is %w", query.GetOffset(), ErrInvalid)
+		}
+		queryOpts = append(queryOpts, WithQueryOffset(int(query.GetOffset())))
+	}
+	if query.Order != nil {
+		order, err := newSortOrderFrom(query.GetOrder())
+		if err != nil {
+			return nil, err
+		}
+		queryOpts = append(queryOpts, WithQueryOrder(order))
+	}
+	if query.PageToken != nil && 0 < len(query.GetPageToken()) {
+		token, err := NewPageTokenFromString(query.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		queryOpts = append(queryOpts, WithQueryPageToken(token))
+	}
 
 	return NewQuery(queryOpts...), nil
 }
 
+func newSortOrderFrom(order v1.SortOrder) (SortOrder, error) {
+	switch order {
+	case v1.SortOrder_SORT_ORDER_ASC:
+		return SortAscending, nil
+	case v1.SortOrder_SORT_ORDER_DESC:
+		return SortDescending, nil
+	}
+	return SortAscending, fmt.Errorf("sort order (%s) is %w", order, ErrInvalid)
+}
+
+func newGrpcSortOrderFrom(order SortOrder) v1.SortOrder {
+	if order == SortDescending {
+		return v1.SortOrder_SORT_ORDER_DESC
+	}
+	return v1.SortOrder_SORT_ORDER_ASC
+}
+
 func newGrpcQueryFromQuery(query Query) *v1.Query {
 	pbQuery := &v1.Query{
-		Kind:  nil,
-		Uuid:  nil,
-		State: nil,
+		Kind:      nil,
+		Uuid:      nil,
+		State:     nil,
+		Limit:     nil,
+		Offset:    nil,
+		Order:     nil,
+		PageToken: nil,
 	}
 	kind, ok := query.Kind()
 	if ok {
@@ -67,5 +122,304 @@ func newGrpcQueryFromQuery(query Query) *v1.Query {
 		}
 		pbQuery.State = &pbState
 	}
+	if limit, ok := query.Limit(); ok {
+		pbLimit := int32(limit) // nolint:gosec
+		pbQuery.Limit = &pbLimit
+	}
+	if offset := query.Offset(); 0 < offset {
+		pbOffset := int32(offset) // nolint:gosec
+		pbQuery.Offset = &pbOffset
+	}
+	if order := query.Order(); order != SortAscending {
+		pbOrder := newGrpcSortOrderFrom(order)
+		pbQuery.Order = &pbOrder
+	}
+	if token, ok := query.PageToken(); ok {
+		pbToken := token.String()
+		pbQuery.PageToken = &pbToken
+	}
 	return pbQuery
 }
+
+func newGrpcTimestampFrom(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func newGrpcInstanceFromInstance(ji Instance) (*v1.JobInstance, error) {
+	state, err := ji.State().protoState()
+	if err != nil {
+		return nil, err
+	}
+	args := []string{}
+	for _, arg := range ji.Arguments() {
+		args = append(args, fmt.Sprintf("%v", arg))
+	}
+	results := []string{}
+	var resultErr *string
+	rs, err := newResultSetFromInstance(ji)
+	if err == nil {
+		for _, r := range rs {
+			b, err := json.Marshal(r)
+			if err != nil {
+				b = []byte(strconv.Quote(fmt.Sprintf("%v", r)))
+			}
+			results = append(results, string(b))
+		}
+	} else {
+		errStr := err.Error()
+		resultErr = &errStr
+	}
+	attempts := int32(ji.Attempts()) // nolint:gosec
+	return &v1.JobInstance{
+		Kind:         ji.Kind(),
+		Uuid:         ji.UUID().String(),
+		State:        state,
+		Arguments:    args,
+		Results:      results,
+		Error:        resultErr,
+		CreatedAt:    newGrpcTimestampFrom(ji.CreatedAt()),
+		ScheduledAt:  newGrpcTimestampFrom(ji.ScheduledAt()),
+		ProcessedAt:  newGrpcTimestampFrom(ji.ProcessedAt()),
+		CompletedAt:  newGrpcTimestampFrom(ji.CompletedAt()),
+		TerminatedAt: newGrpcTimestampFrom(ji.TerminatedAt()),
+		CanceledAt:   newGrpcTimestampFrom(ji.CanceledAt()),
+		TimedOutAt:   newGrpcTimestampFrom(ji.TimeoutedAt()),
+		Attempts:     &attempts,
+	}, nil
+}
+
+func newInstanceFromGrpcInstance(pbInstance *v1.JobInstance) (Instance, error) {
+	uuid, err := NewUUIDFrom(pbInstance.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	state, err := newStateFrom(pbInstance.GetState())
+	if err != nil {
+		return nil, err
+	}
+	args := []any{}
+	for _, arg := range pbInstance.GetArguments() {
+		args = append(args, arg)
+	}
+	rs := ResultSet{}
+	for _, r := range pbInstance.GetResults() {
+		var v any
+		if err := json.Unmarshal([]byte(r), &v); err != nil {
+			v = r
+		}
+		rs = append(rs, v)
+	}
+	opts := []any{
+		WithUUID(uuid),
+		WithKind(pbInstance.GetKind()),
+		WithState(state),
+		WithArguments(args...),
+		WithResultSet(rs),
+		WithAttempts(int(pbInstance.GetAttempts())),
+	}
+	if pbInstance.Error != nil {
+		opts = append(opts, WithResultError(errors.New(pbInstance.GetError())))
+	}
+	return NewInstance(opts...)
+}
+
+func newGrpcAuditRecordFromAuditRecord(record AuditRecord) *v1.AuditRecord {
+	uuids := make([]string, len(record.UUIDs()))
+	for n, uuid := range record.UUIDs() {
+		uuids[n] = uuid.String()
+	}
+	pbRecord := &v1.AuditRecord{
+		Timestamp: newGrpcTimestampFrom(record.Timestamp()),
+		Actor:     record.Actor(),
+		Operation: string(record.Operation()),
+		Kind:      nil,
+		Query:     nil,
+		Uuids:     uuids,
+		Id:        record.ID().String(),
+	}
+	if kind := record.Kind(); 0 < len(kind) {
+		pbRecord.Kind = &kind
+	}
+	if query := record.Query(); 0 < len(query) {
+		pbRecord.Query = &query
+	}
+	return pbRecord
+}
+
+func newAuditRecordFromGrpcAuditRecord(pbRecord *v1.AuditRecord) (AuditRecord, error) {
+	uuids := make([]UUID, len(pbRecord.GetUuids()))
+	for n, s := range pbRecord.GetUuids() {
+		uuid, err := NewUUIDFromString(s)
+		if err != nil {
+			return nil, err
+		}
+		uuids[n] = uuid
+	}
+	opts := []AuditRecordOption{
+		WithAuditRecordTimestamp(pbRecord.GetTimestamp().AsTime()),
+		WithAuditRecordActor(pbRecord.GetActor()),
+		WithAuditRecordOperation(AuditOperation(pbRecord.GetOperation())),
+		WithAuditRecordKind(pbRecord.GetKind()),
+		WithAuditRecordQuery(pbRecord.GetQuery()),
+		WithAuditRecordUUIDs(uuids...),
+	}
+	if id := pbRecord.GetId(); 0 < len(id) {
+		uuid, err := NewUUIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithAuditRecordID(uuid))
+	}
+	return NewAuditRecord(opts...), nil
+}
+
+// newGrpcSubscriptionFromSubscription returns the protobuf subscription. The secret is never returned to the clients.
+func newGrpcSubscriptionFromSubscription(sub Subscription) (*v1.Subscription, error) {
+	states := make([]v1.JobState, len(sub.States()))
+	for n, state := range sub.States() {
+		pbState, err := state.protoState()
+		if err != nil {
+			return nil, err
+		}
+		states[n] = pbState
+	}
+	pbSub := &v1.Subscription{
+		Id:        sub.ID().String(),
+		Url:       sub.URL(),
+		Kind:      nil,
+		States:    states,
+		Secret:    nil,
+		CreatedAt: newGrpcTimestampFrom(sub.CreatedAt()),
+	}
+	if kind := sub.Kind(); 0 < len(kind) {
+		pbSub.Kind = &kind
+	}
+	return pbSub, nil
+}
+
+func newSubscriptionFromGrpcSubscription(pbSub *v1.Subscription) (Subscription, error) {
+	states := make([]JobState, len(pbSub.GetStates()))
+	for n, pbState := range pbSub.GetStates() {
+		state, err := newStateFrom(pbState)
+		if err != nil {
+			return nil, err
+		}
+		states[n] = state
+	}
+	opts := []SubscriptionOption{
+		WithSubscriptionURL(pbSub.GetUrl()),
+		WithSubscriptionKind(pbSub.GetKind()),
+		WithSubscriptionStates(states...),
+		WithSubscriptionSecret(pbSub.GetSecret()),
+	}
+	if id := pbSub.GetId(); 0 < len(id) {
+		uuid, err := NewUUIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSubscriptionID(uuid))
+	}
+	if pbSub.GetCreatedAt() != nil {
+		opts = append(opts, WithSubscriptionCreatedAt(pbSub.GetCreatedAt().AsTime()))
+	}
+	return NewSubscription(opts...)
+}
+
+func newGrpcInstanceStatsFromInstanceStats(s InstanceStats) (*v1.InstanceStats, error) {
+	states := []*v1.StateCount{}
+	for _, state := range []JobState{JobCreated, JobScheduled, JobProcessing, JobCanceled, JobTimedOut, JobCompleted, JobTerminated} {
+		count, ok := s.Counts()[state]
+		if !ok {
+			continue
+		}
+		pbState, err := state.protoState()
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, &v1.StateCount{
+			State: pbState,
+			Count: int64(count),
+		})
+	}
+	pbStats := &v1.InstanceStats{
+		States:            states,
+		QueueDepth:        int64(s.QueueDepth()),
+		OldestScheduledAt: nil,
+		Completed:         int64(s.Completed()),
+		Failed:            int64(s.Failed()),
+		SuccessRate:       s.SuccessRate(),
+		FailureRate:       s.FailureRate(),
+	}
+	if oldest, ok := s.OldestScheduledAt(); ok {
+		pbStats.OldestScheduledAt = timestamppb.New(oldest)
+	}
+	return pbStats, nil
+}
+
+func newInstanceStatsFromGrpcInstanceStats(pbStats *v1.InstanceStats) (*instanceStats, error) {
+	s := newInstanceStats()
+	for _, pbCount := range pbStats.GetStates() {
+		state, err := newStateFrom(pbCount.GetState())
+		if err != nil {
+			return nil, err
+		}
+		s.counts[state] = int(pbCount.GetCount())
+	}
+	s.queueDepth = int(pbStats.GetQueueDepth())
+	if pbStats.GetOldestScheduledAt() != nil {
+		s.oldestScheduledAt = pbStats.GetOldestScheduledAt().AsTime()
+	}
+	s.completed = int(pbStats.GetCompleted())
+	s.failed = int(pbStats.GetFailed())
+	return s, nil
+}
+
+func newGrpcStatsFromStats(s Stats) (*v1.Stats, error) {
+	total, err := newGrpcInstanceStatsFromInstanceStats(s)
+	if err != nil {
+		return nil, err
+	}
+	kinds := []*v1.KindStats{}
+	for _, ks := range s.Kinds() {
+		pbStats, err := newGrpcInstanceStatsFromInstanceStats(ks)
+		if err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, &v1.KindStats{
+			Kind:  ks.Kind(),
+			Stats: pbStats,
+		})
+	}
+	return &v1.Stats{
+		Timestamp:         newGrpcTimestampFrom(s.Timestamp()),
+		Window:            durationpb.New(s.Window()),
+		Total:             total,
+		Kinds:             kinds,
+		Workers:           int32(s.NumWorkers()),     // nolint:gosec
+		BusyWorkers:       int32(s.NumBusyWorkers()), // nolint:gosec
+		WorkerUtilization: s.WorkerUtilization(),
+	}, nil
+}
+
+func newStatsFromGrpcStats(pbStats *v1.Stats) (Stats, error) {
+	total, err := newInstanceStatsFromGrpcInstanceStats(pbStats.GetTotal())
+	if err != nil {
+		return nil, err
+	}
+	s := newStats(WithStatsWindow(pbStats.GetWindow().AsDuration()))
+	s.instanceStats = total
+	s.ts = pbStats.GetTimestamp().AsTime()
+	for _, pbKind := range pbStats.GetKinds() {
+		ks, err := newInstanceStatsFromGrpcInstanceStats(pbKind.GetStats())
+		if err != nil {
+			return nil, err
+		}
+		s.kindStats(pbKind.GetKind()).instanceStats = ks
+	}
+	s.workers = int(pbStats.GetWorkers())
+	s.busyWorkers = int(pbStats.GetBusyWorkers())
+	return s, nil
+}
@@ -22,22 +22,45 @@ import (
 
 	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // gRPC client implementation for client.
 type grpcClient struct {
-	host string
-	port int
-	conn *grpc.ClientConn
+	host      string
+	port      int
+	tlsConfig TLSConfig
+	token     string
+	conn      *grpc.ClientConn
+}
+
+// tokenCredentials attaches a bearer token to each gRPC request.
+type tokenCredentials struct {
+	token string
+}
+
+// GetRequestMetadata returns the authorization metadata of the bearer token.
+func (creds *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationHeader: bearerPrefix + creds.token,
+	}, nil
+}
+
+// RequireTransportSecurity returns false to allow bearer tokens on plaintext connections such as localhost.
+func (creds *tokenCredentials) RequireTransportSecurity() bool {
+	return false
 }
 
 // NewClient returns a new gRPC client.
 func NewGrpcClient() Client {
 	client := &grpcClient{
-		host: "",
-		port: DefaultGRPCPort,
-		conn: nil,
+		host:      "",
+		port:      DefaultGRPCPort,
+		tlsConfig: NewTLSConfig(),
+		token:     "",
+		conn:      nil,
 	}
 	return client
 }
@@ -57,10 +80,34 @@ func (client *grpcClient) SetHost(host string) {
 	client.host = host
 }
 
+// SetTLSConfig sets the TLS configuration. TLS is used if the configuration is enabled.
+func (client *grpcClient) SetTLSConfig(config TLSConfig) {
+	client.tlsConfig = config
+}
+
+// SetAuthToken sets the bearer token to authenticate the client.
+func (client *grpcClient) SetAuthToken(token string) {
+	client.token = token
+}
+
 // Open opens a gRPC connection.
 func (client *grpcClient) Open() error {
+	creds := insecure.NewCredentials()
+	if client.tlsConfig.IsTLSEnabled() {
+		tlsConfig, err := newClientTLSConfig(client.tlsConfig)
+		if err != nil {
+			return err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
 	addr := net.JoinHostPort(client.host, strconv.Itoa(client.port))
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if 0 < len(client.token) {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: client.token}))
+	}
+	conn, err := grpc.NewClient(addr, opts...)
 	if err != nil {
 		return err
 	}
@@ -173,11 +220,15 @@ func (client *grpcClient) LookupInstances(query Query) ([]Instance, error) {
 		if err != nil {
 			return nil, err
 		}
-		pbInstances[i], err = NewInstance(
+		opts := []any{
 			WithUUID(uuid),
 			WithKind(pbInstance.GetKind()),
 			WithState(state),
-		)
+		}
+		if pbInstance.CreatedAt != nil {
+			opts = append(opts, WithCreatedAt(pbInstance.GetCreatedAt().AsTime()))
+		}
+		pbInstances[i], err = NewInstance(opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -209,14 +260,120 @@ func (client *grpcClient) CancelInstances(query Query) ([]Instance, error) {
 		if err != nil {
 			return nil, err
 		}
-		pbInstances[i], err = NewInstance(
+		opts := []any{
 			WithUUID(uuid),
 			WithKind(pbInstance.GetKind()),
 			WithState(state),
-		)
+		}
+		if pbInstance.CreatedAt != nil {
+			opts = append(opts, WithCreatedAt(pbInstance.GetCreatedAt().AsTime()))
+		}
+		pbInstances[i], err = NewInstance(opts...)
 		if err != nil {
 			return nil, err
 		}
 	}
 	return pbInstances, nil
 }
+
+// WaitInstance waits until the specified job instance reaches a final state, and returns the job instance including its results or error.
+func (client *grpcClient) WaitInstance(ctx context.Context, uuid UUID) (Instance, error) {
+	c := v1.NewJobServiceClient(client.conn)
+	req := &v1.WaitInstanceRequest{
+		Uuid: uuid.String(),
+	}
+	res, err := c.WaitInstance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return newInstanceFromGrpcInstance(res.GetInstance())
+}
+
+// LookupAuditRecords looks up audit records of administrative operations based on the provided query.
+func (client *grpcClient) LookupAuditRecords(query Query) ([]AuditRecord, error) {
+	c := v1.NewJobServiceClient(client.conn)
+
+	req := &v1.LookupAuditRecordsRequest{
+		Query: newGrpcQueryFromQuery(query),
+	}
+	res, err := c.LookupAuditRecords(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]AuditRecord, len(res.GetRecords()))
+	for n, pbRecord := range res.GetRecords() {
+		records[n], err = newAuditRecordFromGrpcAuditRecord(pbRecord)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// AddSubscription adds a webhook subscription notified of instance state changes, and returns the added subscription.
+func (client *grpcClient) AddSubscription(sub Subscription) (Subscription, error) {
+	c := v1.NewJobServiceClient(client.conn)
+
+	pbSub, err := newGrpcSubscriptionFromSubscription(sub)
+	if err != nil {
+		return nil, err
+	}
+	if secret := sub.Secret(); 0 < len(secret) {
+		pbSub.Secret = &secret
+	}
+
+	req := &v1.AddSubscriptionRequest{
+		Subscription: pbSub,
+	}
+	res, err := c.AddSubscription(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return newSubscriptionFromGrpcSubscription(res.GetSubscription())
+}
+
+// RemoveSubscription removes the webhook subscription with the specified identifier.
+func (client *grpcClient) RemoveSubscription(id UUID) error {
+	c := v1.NewJobServiceClient(client.conn)
+
+	req := &v1.RemoveSubscriptionRequest{
+		Id: id.String(),
+	}
+	_, err := c.RemoveSubscription(context.Background(), req)
+	return err
+}
+
+// ListSubscriptions lists all webhook subscriptions.
+func (client *grpcClient) ListSubscriptions() ([]Subscription, error) {
+	c := v1.NewJobServiceClient(client.conn)
+
+	req := &v1.ListSubscriptionsRequest{}
+	res, err := c.ListSubscriptions(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]Subscription, len(res.GetSubscriptions()))
+	for n, pbSub := range res.GetSubscriptions() {
+		subs[n], err = newSubscriptionFromGrpcSubscription(pbSub)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return subs, nil
+}
+
+// GetStats retrieves the aggregate statistics of job instances by kind and state, the queue and the workers.
+func (client *grpcClient) GetStats(opts ...StatsOption) (Stats, error) {
+	c := v1.NewJobServiceClient(client.conn)
+
+	req := &v1.GetStatsRequest{
+		Window: durationpb.New(newStats(opts...).Window()),
+	}
+	res, err := c.GetStats(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return newStatsFromGrpcStats(res.GetStats())
+}
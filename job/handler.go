@@ -44,6 +44,23 @@ func WithExecutor(executor Executor) HandlerOption {
 	}
 }
 
+// WithArgumentNames sets the names of the executor parameters, which are used to bind named arguments.
+// The names are assigned in order to the executor parameters excluding the auto-injected ones such as context.Context, Manager, Worker, and Instance.
+func WithArgumentNames(names ...string) HandlerOption {
+	return func(h *handler) {
+		h.argNames = names
+	}
+}
+
+// WithFailOnErrorResult sets the job handler to treat a non-nil error returned as the last result of the executor as a failure of the job instance.
+// By default, the returned error is stored in the result set as it is, and the job instance is completed successfully.
+// If the option is set, the job instance is terminated with the error, so that the terminate processor and the retry policy are applied.
+func WithFailOnErrorResult() HandlerOption {
+	return func(h *handler) {
+		h.failOnErrorResult = true
+	}
+}
+
 // WithStateChangeProcessor sets a handler function that is invoked each time the state of a job instance changes while being processed by the local worker.
 // NOTE: In a distributed environment with multiple worker groups, the worker that schedules a job instance may not receive all status updates for that instance.
 func WithStateChangeProcessor(fn StateChangeProcessor) HandlerOption {
@@ -72,12 +89,18 @@ func WithCompleteProcessor(fn CompleteProcessor) HandlerOption {
 type Handler interface {
 	// Executor returns the executor function set for the job handler.
 	Executor() Executor
+	// ArgumentNames returns the names of the executor parameters set for the job handler.
+	ArgumentNames() []string
 	// StateChangeProcessor returns the state change handler function set for the job handler.
 	StateChangeProcessor() StateChangeProcessor
 	// CompleteProcessor returns the completion handler function set for the job handler.
 	CompleteProcessor() CompleteProcessor
 	// TerminateProcessor returns the error processor function set for the job handler.
 	TerminateProcessor() TerminateProcessor
+	// FailOnErrorResult returns true if a non-nil error returned as the last result of the executor is treated as a failure.
+	FailOnErrorResult() bool
+	// Middlewares returns the middlewares composed around the executor of the job handler.
+	Middlewares() []Middleware
 	// Execute runs the job with the provided parameters.
 	Execute(ctx context.Context, args []any, opts ...any) ([]any, error)
 	// HandleTerminated processes errors that occur during job execution.
@@ -88,17 +111,23 @@ type Handler interface {
 
 type handler struct {
 	executor           Executor
+	argNames           []string
 	stateChgProcessor  StateChangeProcessor
 	terminateProcessor TerminateProcessor
 	completeProcessor  CompleteProcessor
+	failOnErrorResult  bool
+	middlewares        []Middleware
 }
 
 func newHandler(opts ...HandlerOption) *handler {
 	h := &handler{
 		executor:           nil,
+		argNames:           nil,
 		stateChgProcessor:  nil,
 		terminateProcessor: nil,
 		completeProcessor:  nil,
+		failOnErrorResult:  false,
+		middlewares:        nil,
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -111,6 +140,11 @@ func (h *handler) Executor() Executor {
 	return h.executor
 }
 
+// ArgumentNames returns the names of the executor parameters set for the job handler.
+func (h *handler) ArgumentNames() []string {
+	return h.argNames
+}
+
 // StateChangeProcessor returns the state change handler function set for the job handler.
 func (h *handler) StateChangeProcessor() StateChangeProcessor {
 	return h.stateChgProcessor
@@ -126,19 +160,50 @@ func (h *handler) CompleteProcessor() CompleteProcessor {
 	return h.completeProcessor
 }
 
-// Execute runs the job using the executor function, if set.
+// FailOnErrorResult returns true if a non-nil error returned as the last result of the executor is treated as a failure.
+func (h *handler) FailOnErrorResult() bool {
+	return h.failOnErrorResult
+}
+
+// Middlewares returns the middlewares composed around the executor of the job handler.
+func (h *handler) Middlewares() []Middleware {
+	return h.middlewares
+}
+
+// Execute runs the job using the executor function, if set, through the middlewares of the job handler.
 func (h *handler) Execute(ctx context.Context, args []any, opts ...any) ([]any, error) {
 	if h.executor == nil {
 		return nil, fmt.Errorf("no executor set for job handler")
 	}
+	if len(h.middlewares) == 0 {
+		return h.execute(ctx, args, opts...)
+	}
+	execute := func(mwCtx context.Context, _ Instance, args []any) ([]any, error) {
+		if mwCtx != ctx {
+			return h.execute(mwCtx, args, replaceContextOption(opts, mwCtx)...)
+		}
+		return h.execute(ctx, args, opts...)
+	}
+	return chainMiddlewares(execute, h.middlewares...)(ctx, instanceFromOptions(opts), args)
+}
+
+func (h *handler) execute(ctx context.Context, args []any, opts ...any) ([]any, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
+		if 0 < len(h.argNames) {
+			opts = append(opts, ArgumentNames(h.argNames))
+		}
 		res, err := Execute(h.executor, args, opts...)
 		if err != nil {
 			return nil, err
 		}
+		if h.failOnErrorResult && 0 < len(res) {
+			if err, ok := res[len(res)-1].(error); ok && err != nil {
+				return res, err
+			}
+		}
 		return res, nil
 	}
 }
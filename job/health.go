@@ -0,0 +1,45 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Pinger is an optional interface of stores which check the connectivity to their backends.
+// The readiness of the manager checks the connectivity of the store by Ping if the store is a Pinger.
+type Pinger interface {
+	// Ping checks the connectivity to the backend of the store.
+	Ping(ctx context.Context) error
+}
+
+// Ready returns nil if the manager is ready to process jobs. Otherwise, it returns an error which wraps ErrNotReady,
+// such as when the store couldn't be started or doesn't respond to the ping, or the workers are not running.
+func (mgr *manager) Ready(ctx context.Context) error {
+	var errs error
+	if !mgr.storeStarted.Load() {
+		errs = errors.Join(errs, fmt.Errorf("store (%s) is %w", mgr.store.Name(), ErrNotReady))
+	} else if pinger, ok := mgr.store.(Pinger); ok {
+		if err := pinger.Ping(ctx); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("store (%s) is %w: %w", mgr.store.Name(), ErrNotReady, err))
+		}
+	}
+	if !mgr.workerGroup.isRunning() {
+		errs = errors.Join(errs, fmt.Errorf("workers are %w", ErrNotReady))
+	}
+	return errs
+}
@@ -16,7 +16,6 @@ package job
 
 import (
 	"context"
-	"sort"
 )
 
 // History is an interface that defines methods for managing the history of job instance state changes.
@@ -31,7 +30,7 @@ type History interface {
 type StateHistory interface {
 	// LogProcessState logs a state change for a job instance.
 	LogProcessState(job Instance, state JobState, opts ...instanceStateOption) error
-	// LookupHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp.
+	// LookupHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp in the order of the query.
 	LookupHistory(query Query) (InstanceHistory, error)
 	// ClearHistory clears all state records for a job instance that match the specified filter.
 	ClearHistory(filter Filter) error
@@ -47,7 +46,7 @@ type LogHistory interface {
 	Errorf(job Instance, format string, args ...any) error
 	// Debugf logs a debug message for a job instance.
 	Debugf(job Instance, format string, args ...any) error
-	// LookupLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp.
+	// LookupLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp in the order of the query.
 	LookupLogs(query Query) ([]Log, error)
 	// ClearLogs clears all log entries for a job instance that match the specified filter.
 	ClearLogs(filter Filter) error
@@ -63,15 +62,24 @@ func withHistoryStore(store HistoryStore) historyOption {
 	}
 }
 
+// withHistoryStateListener adds a listener which is called after a state change is logged.
+func withHistoryStateListener(listener func(InstanceState)) historyOption {
+	return func(h *history) {
+		h.listeners = append(h.listeners, listener)
+	}
+}
+
 // history keeps track of the state changes of a job.
 type history struct {
-	store HistoryStore
+	store     HistoryStore
+	listeners []func(InstanceState)
 }
 
 // newHistory creates a new job state history.
 func newHistory(opts ...historyOption) *history {
 	history := &history{
-		store: NewLocalStore(),
+		store:     NewLocalStore(),
+		listeners: nil,
 	}
 	for _, opt := range opts {
 		opt(history)
@@ -85,18 +93,23 @@ func (history *history) LogProcessState(job Instance, state JobState, opts ...in
 	opts = append(opts, withStateUUID(job.UUID()))
 	opts = append(opts, withStateJobState(state))
 	record := newInstanceState(opts...)
-	return history.store.LogInstanceState(context.Background(), record)
+	err := history.store.LogInstanceState(context.Background(), record)
+	if err != nil {
+		return err
+	}
+	for _, listener := range history.listeners {
+		listener(record)
+	}
+	return nil
 }
 
-// LookupHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp.
+// LookupHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp in the order of the query.
 func (history *history) LookupHistory(query Query) (InstanceHistory, error) {
 	records, err := history.store.LookupInstanceHistory(context.Background(), query)
 	if err != nil {
 		return nil, err
 	}
-	sort.Slice(records, func(i, j int) bool {
-		return records[i].Timestamp().Before(records[j].Timestamp())
-	})
+	sortByPageKey(records, query.Order())
 	return records, nil
 }
 
@@ -125,7 +138,7 @@ func (history *history) Debugf(job Instance, format string, args ...any) error {
 	return history.store.Debugf(context.Background(), job, format, args...)
 }
 
-// LookupLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp.
+// LookupLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp in the order of the query.
 func (history *history) LookupLogs(query Query) ([]Log, error) {
 	return history.store.LookupInstanceLogs(context.Background(), query)
 }
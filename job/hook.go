@@ -0,0 +1,190 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+)
+
+// ScheduleHook is called when a job instance is scheduled by the manager.
+type ScheduleHook = func(job Instance)
+
+// DequeueHook is called when a job instance is dequeued by a worker of the manager.
+type DequeueHook = func(job Instance)
+
+// BeforeExecuteHook is called before the executor of a job instance runs.
+// The returned context is passed to the executor, so that hooks can attach values such as trace spans or tenant tags.
+// If the hook returns an error, the executor is not run and the job instance is terminated with the error.
+type BeforeExecuteHook = func(ctx context.Context, job Instance) (context.Context, error)
+
+// AfterExecuteHook is called after the executor of a job instance returns, with its results and error.
+type AfterExecuteHook = func(ctx context.Context, job Instance, responses []any, err error)
+
+// StateChangeHook is called each time the state of a job instance changes.
+type StateChangeHook = func(job Instance, state JobState)
+
+// RetryHook is called when a failed job instance is scheduled to be retried, with the error of the failed attempt.
+type RetryHook = func(job Instance, err error)
+
+// WithOnSchedule adds a hook which is called when a job instance of any kind is scheduled by the manager.
+func WithOnSchedule(fn ScheduleHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.onSchedule = append(m.hooks.onSchedule, fn)
+	}
+}
+
+// WithOnDequeue adds a hook which is called when a job instance of any kind is dequeued by a worker of the manager.
+func WithOnDequeue(fn DequeueHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.onDequeue = append(m.hooks.onDequeue, fn)
+	}
+}
+
+// WithBeforeExecute adds a hook which is called before the executor of a job instance of any kind runs.
+// The hooks are called in the order they are added.
+func WithBeforeExecute(fn BeforeExecuteHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.beforeExecute = append(m.hooks.beforeExecute, fn)
+	}
+}
+
+// WithAfterExecute adds a hook which is called after the executor of a job instance of any kind returns.
+// The hooks are called in the reverse order they are added, so that they can close what the before hooks opened.
+func WithAfterExecute(fn AfterExecuteHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.afterExecute = append(m.hooks.afterExecute, fn)
+	}
+}
+
+// WithOnStateChange adds a hook which is called each time the state of a job instance of any kind changes on the manager.
+// Unlike WithStateChangeProcessor, the hook is also called for job instances dequeued from a remote store.
+func WithOnStateChange(fn StateChangeHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.onStateChange = append(m.hooks.onStateChange, fn)
+	}
+}
+
+// WithOnRetry adds a hook which is called when a failed job instance of any kind is scheduled to be retried.
+func WithOnRetry(fn RetryHook) ManagerOption {
+	return func(m *manager) {
+		m.hooks.onRetry = append(m.hooks.onRetry, fn)
+	}
+}
+
+// hooks holds the manager-level hooks applied to job instances of all kinds.
+// All methods are safe to call on a nil hooks.
+type hooks struct {
+	onSchedule    []ScheduleHook
+	onDequeue     []DequeueHook
+	beforeExecute []BeforeExecuteHook
+	afterExecute  []AfterExecuteHook
+	onStateChange []StateChangeHook
+	onRetry       []RetryHook
+	middlewares   []Middleware
+}
+
+func newHooks() *hooks {
+	return &hooks{
+		onSchedule:    nil,
+		onDequeue:     nil,
+		beforeExecute: nil,
+		afterExecute:  nil,
+		onStateChange: nil,
+		onRetry:       nil,
+		middlewares:   nil,
+	}
+}
+
+func (h *hooks) schedule(ji Instance) {
+	if h == nil {
+		return
+	}
+	for _, fn := range h.onSchedule {
+		fn(ji)
+	}
+}
+
+func (h *hooks) dequeue(ji Instance) {
+	if h == nil {
+		return
+	}
+	for _, fn := range h.onDequeue {
+		fn(ji)
+	}
+}
+
+func (h *hooks) before(ctx context.Context, ji Instance) (context.Context, error) {
+	if h == nil {
+		return ctx, nil
+	}
+	for _, fn := range h.beforeExecute {
+		hookCtx, err := fn(ctx, ji)
+		if hookCtx != nil {
+			ctx = hookCtx
+		}
+		if err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+func (h *hooks) after(ctx context.Context, ji Instance, res []any, err error) {
+	if h == nil {
+		return
+	}
+	for n := len(h.afterExecute) - 1; 0 <= n; n-- {
+		h.afterExecute[n](ctx, ji, res, err)
+	}
+}
+
+func (h *hooks) wrap(fn ExecuteFunc) ExecuteFunc {
+	if h == nil {
+		return fn
+	}
+	return chainMiddlewares(fn, h.middlewares...)
+}
+
+func (h *hooks) stateChange(ji Instance, state JobState) {
+	if h == nil {
+		return
+	}
+	for _, fn := range h.onStateChange {
+		fn(ji, state)
+	}
+}
+
+func (h *hooks) retry(ji Instance, err error) {
+	if h == nil {
+		return
+	}
+	for _, fn := range h.onRetry {
+		fn(ji, err)
+	}
+}
+
+// replaceContextOption returns a copy of the options in which context values are replaced with the specified context,
+// so that executors receive the context returned by the before hooks.
+func replaceContextOption(opts []any, ctx context.Context) []any {
+	replaced := make([]any, len(opts))
+	for n, opt := range opts {
+		if _, ok := opt.(context.Context); ok {
+			replaced[n] = ctx
+			continue
+		}
+		replaced[n] = opt
+	}
+	return replaced
+}
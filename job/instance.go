@@ -17,9 +17,11 @@ package job
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"time"
 
 	"github.com/cybergarage/go-job/job/encoding"
+	logger "github.com/cybergarage/go-logger/log"
 	"github.com/google/uuid"
 )
 
@@ -67,6 +69,8 @@ type Instance interface {
 	// Result returns the processed result set of the executor when the job instance is completed or terminated.
 	// If the job instance is not completed or terminated, it returns an error.
 	ResultSet() (ResultSet, error)
+	// Await waits until the job instance reaches a final state, and returns the result set and error of the instance.
+	Await(ctx context.Context) (ResultSet, error)
 	// History returns the history of state changes for the job instance.
 	History() (InstanceHistory, error)
 	// Logs returns the logs for the job instance.
@@ -112,6 +116,13 @@ type jobInstance struct {
 	timedoutAt   time.Time
 	resultSet    ResultSet
 	resultError  error
+	resultCodec  ResultCodec
+	claimChecker *claimChecker
+	hooks        *hooks
+	tracing      *tracing
+	metrics      *metrics
+	traceContext map[string]string
+	enqueuedAt   time.Time
 	ctx          context.Context
 }
 
@@ -137,10 +148,17 @@ func WithJob(job Job) InstanceOption {
 
 		handlerOpts := []HandlerOption{
 			WithExecutor(job.Handler().Executor()),
+			WithArgumentNames(job.Handler().ArgumentNames()...),
 			WithStateChangeProcessor(job.Handler().StateChangeProcessor()),
 			WithTerminateProcessor(job.Handler().TerminateProcessor()),
 			WithCompleteProcessor(job.Handler().CompleteProcessor()),
 		}
+		if job.Handler().FailOnErrorResult() {
+			handlerOpts = append(handlerOpts, WithFailOnErrorResult())
+		}
+		if mw := job.Handler().Middlewares(); 0 < len(mw) {
+			handlerOpts = append(handlerOpts, WithMiddleware(mw...))
+		}
 		for _, opt := range handlerOpts {
 			opt(ji.handler)
 		}
@@ -188,12 +206,61 @@ func WithInstanceHistory(history History) InstanceOption {
 	}
 }
 
-// withInstanceStore sets the store for the job instance history.
-func withInstanceStore(store Store) InstanceOption {
+// withInstanceResultCodec sets the codec used to record the result set in the job instance history.
+func withInstanceResultCodec(codec ResultCodec) InstanceOption {
+	return func(ji *jobInstance) error {
+		if codec == nil {
+			return fmt.Errorf("result codec is %w", ErrNil)
+		}
+		ji.resultCodec = codec
+		return nil
+	}
+}
+
+// withInstanceClaimChecker sets the claim checker used to fetch offloaded arguments before execution.
+func withInstanceClaimChecker(cc *claimChecker) InstanceOption {
+	return func(ji *jobInstance) error {
+		ji.claimChecker = cc
+		return nil
+	}
+}
+
+// withInstanceHooks sets the manager-level hooks applied to the job instance.
+func withInstanceHooks(h *hooks) InstanceOption {
+	return func(ji *jobInstance) error {
+		ji.hooks = h
+		return nil
+	}
+}
+
+// withInstanceTracing sets the tracing which records the spans of the job instance.
+func withInstanceTracing(t *tracing) InstanceOption {
 	return func(ji *jobInstance) error {
-		ji.history = newHistory(
-			withHistoryStore(store),
-		)
+		ji.tracing = t
+		return nil
+	}
+}
+
+// withInstanceMetrics sets the metrics of the manager measured by the job instance.
+func withInstanceMetrics(m *metrics) InstanceOption {
+	return func(ji *jobInstance) error {
+		ji.metrics = m
+		return nil
+	}
+}
+
+// withInstanceTraceContext sets the trace context propagated with the job instance.
+func withInstanceTraceContext(carrier map[string]string) InstanceOption {
+	return func(ji *jobInstance) error {
+		ji.traceContext = carrier
+		return nil
+	}
+}
+
+// withInstanceEnqueuedAt sets the time when the job instance was enqueued.
+func withInstanceEnqueuedAt(t time.Time) InstanceOption {
+	return func(ji *jobInstance) error {
+		ji.enqueuedAt = t
 		return nil
 	}
 }
@@ -315,6 +382,13 @@ func NewInstance(opts ...any) (Instance, error) {
 		timedoutAt:    time.Time{},
 		resultSet:     nil,
 		resultError:   nil,
+		resultCodec:   NewJSONResultCodec(),
+		claimChecker:  nil,
+		hooks:         nil,
+		tracing:       nil,
+		metrics:       nil,
+		traceContext:  nil,
+		enqueuedAt:    time.Time{},
 		ctx:           context.Background(),
 	}
 
@@ -372,6 +446,22 @@ func NewInstanceFromMap(m map[string]any, opts ...any) (Instance, error) {
 				return nil, err
 			}
 			opts = append(opts, WithArguments(args.Arguments()...))
+		case namedArgumentsKey:
+			namedArgs, err := newNamedArgumentsFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithNamedArguments(namedArgs))
+		case resultSetKey:
+			rs, ok := newInstanceMapWith(m).ResultSet(NewJSONResultCodec())
+			if ok {
+				opts = append(opts, WithResultSet(rs))
+			}
+		case errorKey:
+			err, ok := newInstanceMapWith(m).Error()
+			if ok {
+				opts = append(opts, WithResultError(err))
+			}
 		case crontabKey:
 			crontabSpec, err := newCrontabSpecFrom(value)
 			if err != nil {
@@ -402,6 +492,23 @@ func NewInstanceFromMap(m map[string]any, opts ...any) (Instance, error) {
 				return nil, err
 			}
 			opts = append(opts, WithTimeout(timeout))
+		case traceContextKey:
+			carrier, ok := newTraceContextFrom(value)
+			if ok {
+				opts = append(opts, withInstanceTraceContext(carrier))
+			}
+		case enqueuedAtKey:
+			enqueuedAt, err := NewTimestampFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, withInstanceEnqueuedAt(enqueuedAt.Time()))
+		case createdAtKey:
+			createdAt, err := NewTimestampFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithCreatedAt(createdAt.Time()))
 		}
 	}
 	return NewInstance(opts...)
@@ -453,6 +560,22 @@ func (ji *jobInstance) ScheduledAt() time.Time {
 	return ji.Next()
 }
 
+// readyAt returns the time when the enqueued job instance became ready to be dequeued, which is the later of the enqueued time and the scheduled time.
+// It returns the zero time if the enqueued time is unknown.
+func (ji *jobInstance) readyAt() time.Time {
+	readyAt := ji.enqueuedAt
+	if readyAt.IsZero() {
+		return readyAt
+	}
+	if ji.cronSchedule != nil {
+		return ji.cronSchedule.Next(readyAt)
+	}
+	if readyAt.Before(ji.scheduleAt) {
+		return ji.scheduleAt
+	}
+	return readyAt
+}
+
 // ProcessedAt returns the time when the job instance started processing.
 func (ji *jobInstance) ProcessedAt() time.Time {
 	return ji.processedAt
@@ -494,9 +617,30 @@ func (ji *jobInstance) Process(ctx context.Context, opts ...any) ([]any, error)
 		ji.resultError = ctx.Err()
 	default:
 		ji.attempt++
-		args := make([]any, len(ji.Arguments()))
-		copy(args, ji.Arguments())
-		ji.resultSet, ji.resultError = ji.Execute(ctx, args, opts...)
+		args, namedArgs, err := ji.claimChecker.fetch(ctx, ji)
+		if err != nil {
+			ji.resultError = err
+			break
+		}
+		if 0 < len(namedArgs) {
+			opts = append(opts, namedArgs)
+		}
+		hookCtx := ctx
+		ji.resultSet, ji.resultError = ji.recoverPanic(func() ([]any, error) {
+			var err error
+			hookCtx, err = ji.hooks.before(ctx, ji)
+			if err != nil {
+				return nil, err
+			}
+			execute := func(mwCtx context.Context, _ Instance, args []any) ([]any, error) {
+				if mwCtx != ctx {
+					return ji.Execute(mwCtx, args, replaceContextOption(opts, mwCtx)...)
+				}
+				return ji.Execute(ctx, args, opts...)
+			}
+			return ji.hooks.wrap(execute)(hookCtx, ji, args)
+		})
+		ji.hooks.after(hookCtx, ji, ji.resultSet, ji.resultError)
 
 		if ctx.Err() != nil {
 			ji.resultError = ctx.Err()
@@ -510,6 +654,23 @@ func (ji *jobInstance) Process(ctx context.Context, opts ...any) ([]any, error)
 	return ji.resultSet, ji.resultError
 }
 
+// recoverPanic runs the execution function, and converts a panic in the function into an error which wraps ErrPanicked
+// with the panic value and the stack trace, so that a panicking executor terminates only its job instance.
+func (ji *jobInstance) recoverPanic(fn func() ([]any, error)) (res []any, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		ji.metrics.panic(ji.Kind())
+		res = nil
+		err = fmt.Errorf("%w: %v\n%s", ErrPanicked, r, debug.Stack())
+		logger.Errorf("job instance %s (%s) %s", ji.uuid, ji.Kind(), err)
+		ji.Error(err)
+	}()
+	return fn()
+}
+
 // Result returns the processed result set of the executor when the job instance is completed or terminated.
 // If the job instance is not completed or terminated, it returns an error.
 func (ji *jobInstance) ResultSet() (ResultSet, error) {
@@ -523,19 +684,16 @@ func (ji *jobInstance) ResultSet() (ResultSet, error) {
 func (ji *jobInstance) UpdateState(state JobState, opts ...any) error {
 	now := time.Now()
 	ji.state = state
+	ji.metrics.stateChange(ji.Kind(), state)
 	switch state {
 	case JobCompleted:
-		mCompletedJobs.WithLabelValues(ji.Kind()).Inc()
 		ji.completedAt = now
 	case JobTerminated:
-		mTerminatedJobs.WithLabelValues(ji.Kind()).Inc()
 		ji.terminatedAt = now
 	case JobCanceled:
-		mCanceledJobs.WithLabelValues(ji.Kind()).Inc()
 		ji.canceledAt = now
 		ji.terminatedAt = now
 	case JobTimedOut:
-		mTimedOutJobs.WithLabelValues(ji.Kind()).Inc()
 		ji.timedoutAt = now
 		ji.terminatedAt = now
 	}
@@ -550,7 +708,14 @@ func (ji *jobInstance) UpdateState(state JobState, opts ...any) error {
 		case error:
 			optMap[errorKey] = opt.Error()
 		case ResultSet:
-			optMap[resultSetKey] = newResultWith(opt).String()
+			rs, err := ji.resultCodec.Encode(opt)
+			if err != nil {
+				logger.Warnf("failed to encode result set: %s", err)
+				optMap[resultSetKey] = opt.String()
+				continue
+			}
+			optMap[resultSetKey] = rs
+			optMap[resultCodecKey] = ji.resultCodec.Name()
 		case map[string]any:
 			optMap = encoding.MergeMaps(optMap, opt)
 		}
@@ -565,6 +730,8 @@ func (ji *jobInstance) UpdateState(state JobState, opts ...any) error {
 	if chgProcessor != nil {
 		chgProcessor(ji, state)
 	}
+	ji.hooks.stateChange(ji, state)
+	ji.tracing.stateChange(ji, state)
 
 	return nil
 }
@@ -605,14 +772,35 @@ func (ji *jobInstance) Equal(other Instance) bool {
 }
 
 // Map returns a map representation of the job instance.
+// The result set and error are included when the job instance is completed or terminated.
 func (ji *jobInstance) Map() map[string]any {
-	return encoding.MergeMaps(
-		map[string]any{
-			kindKey:  ji.Kind(),
-			uuidKey:  ji.uuid.String(),
-			stateKey: ji.State().String(),
-		},
-		ji.OptionMap())
+	m := map[string]any{
+		kindKey:  ji.Kind(),
+		uuidKey:  ji.uuid.String(),
+		stateKey: ji.State().String(),
+	}
+	if ji.state == JobCompleted && ji.resultSet != nil {
+		rs, err := ji.resultCodec.Encode(ji.resultSet)
+		if err == nil {
+			m[resultSetKey] = rs
+			m[resultCodecKey] = ji.resultCodec.Name()
+		} else {
+			m[resultSetKey] = ji.resultSet.String()
+		}
+	}
+	if ji.resultError != nil {
+		m[errorKey] = ji.resultError.Error()
+	}
+	if 0 < len(ji.traceContext) {
+		m[traceContextKey] = ji.traceContext
+	}
+	if !ji.enqueuedAt.IsZero() {
+		m[enqueuedAtKey] = NewTimestampFromTime(ji.enqueuedAt).String()
+	}
+	if !ji.createdAt.IsZero() {
+		m[createdAtKey] = NewTimestampFromTime(ji.createdAt).String()
+	}
+	return encoding.MergeMaps(m, ji.OptionMap())
 }
 
 // OptionMap returns a map of options for the job instance, merging job, arguments, schedule, and policy options.
@@ -30,8 +30,8 @@ func newInstancesFromQueue(queue InstanceQueue) ([]Instance, error) {
 }
 
 // NewInstancesFromStore creates a list of job instances from the provided store.
-func newInstancesFromHistory(history InstanceHistory) ([]Instance, error) {
-	attempt := 0
+func newInstancesFromHistory(history InstanceHistory, codec ResultCodec) ([]Instance, error) {
+	attempts := make(map[uuid.UUID]int)
 	jiOptsMap := make(map[uuid.UUID][]any)
 	for _, state := range history {
 		uuid := state.UUID()
@@ -40,6 +40,8 @@ func newInstancesFromHistory(history InstanceHistory) ([]Instance, error) {
 			jiOpts = make([]any, 0)
 			jiOpts = append(jiOpts, WithUUID(state.UUID()))
 			jiOpts = append(jiOpts, WithKind(state.Kind()))
+			// The creation time is the first state record unless the created state record is found, so that the instances are sorted stably.
+			jiOpts = append(jiOpts, WithCreatedAt(state.Timestamp()))
 		}
 		jiOpts = append(jiOpts, WithState(state.State()))
 		stateMap := newInstanceMapWith(state.Map())
@@ -50,15 +52,24 @@ func newInstancesFromHistory(history InstanceHistory) ([]Instance, error) {
 			if ok {
 				jiOpts = append(jiOpts, WithArguments(args.Arguments()...))
 			}
+			namedArgs, ok := stateMap.NamedArguments()
+			if ok {
+				jiOpts = append(jiOpts, WithNamedArguments(namedArgs))
+			}
+			maxRetries, ok := stateMap.MaxRetries()
+			if ok {
+				jiOpts = append(jiOpts, WithMaxRetries(maxRetries))
+			}
 		case JobScheduled:
 			jiOpts = append(jiOpts, WithScheduleAt(state.Timestamp()))
 		case JobProcessing:
-			attempt++
+			attempts[uuid]++
 			jiOpts = append(jiOpts, WithProcessingAt(state.Timestamp()))
-			jiOpts = append(jiOpts, WithAttempts(attempt))
+			jiOpts = append(jiOpts, WithAttempts(attempts[uuid]))
 		case JobCompleted:
 			jiOpts = append(jiOpts, WithCompletedAt(state.Timestamp()))
-			resultSet, ok := stateMap.ResultSet()
+			jiOpts = append(jiOpts, WithResultError(nil)) // Discard the error of the previous attempts
+			resultSet, ok := stateMap.ResultSet(codec)
 			if ok {
 				jiOpts = append(jiOpts, WithResultSet(resultSet))
 			}
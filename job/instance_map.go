@@ -16,6 +16,7 @@ package job
 
 import (
 	"fmt"
+	"strings"
 )
 
 // instanceMap is a map representation of a job instance.
@@ -43,16 +44,90 @@ func (im instanceMap) Arguments() (Arguments, bool) {
 	return nil, false
 }
 
+// NamedArguments returns the named arguments from the instance map if they exist.
+func (im instanceMap) NamedArguments() (NamedArguments, bool) {
+	if args, ok := im[namedArgumentsKey]; ok {
+		v, err := newNamedArgumentsFrom(args)
+		if err != nil {
+			return nil, false
+		}
+		return v, true
+	}
+	return nil, false
+}
+
+// MaxRetries returns the maximum number of retries from the instance map if it exists.
+func (im instanceMap) MaxRetries() (int, bool) {
+	if v, ok := im[maxRetriesKey]; ok {
+		maxRetries, err := newMaxRetriesFrom(v)
+		if err != nil {
+			return 0, false
+		}
+		return maxRetries, true
+	}
+	return 0, false
+}
+
 // ResultSet returns the result set from the instance map if it exists.
-func (im instanceMap) ResultSet() (ResultSet, bool) {
-	if rs, ok := im[resultSetKey]; ok {
-		if resultSet, ok := rs.(ResultSet); ok {
+// The result set is decoded with the codec recorded with it, which is the specified codec or the JSON codec.
+// Result sets recorded without a codec are in the legacy string format, and are decoded as string values.
+// A result set which cannot be decoded is returned as a single raw string.
+func (im instanceMap) ResultSet(codec ResultCodec) (ResultSet, bool) {
+	rs, ok := im[resultSetKey]
+	if !ok {
+		return nil, false
+	}
+	name, ok := im[resultCodecKey].(string)
+	if !ok {
+		if s, ok := rs.(string); ok {
+			return newResultSetFromLegacyString(s), true
+		}
+		resultSet, err := newResultSetFrom(rs, codec)
+		if err != nil {
+			return nil, false
+		}
+		return resultSet, true
+	}
+	switch name {
+	case codec.Name():
+	case jsonResultCodecName:
+		codec = NewJSONResultCodec()
+	default:
+		codec = nil
+	}
+	if codec != nil {
+		resultSet, err := newResultSetFrom(rs, codec)
+		if err == nil {
 			return resultSet, true
 		}
 	}
+	if s, ok := rs.(string); ok {
+		return ResultSet{s}, true
+	}
 	return nil, false
 }
 
+// newResultSetFromLegacyString returns the result set of the specified string in the legacy format of ResultSet.String,
+// such as "[1, a]". The values are returned as strings because their types are not recorded in the legacy format.
+func newResultSetFromLegacyString(s string) ResultSet {
+	v, ok := strings.CutPrefix(s, "[")
+	if ok {
+		v, ok = strings.CutSuffix(v, "]")
+	}
+	if !ok {
+		return ResultSet{s}
+	}
+	if len(v) == 0 {
+		return ResultSet{}
+	}
+	values := strings.Split(v, ", ")
+	rs := make(ResultSet, len(values))
+	for n, value := range values {
+		rs[n] = value
+	}
+	return rs
+}
+
 // Error returns the error from the instance map if it exists.
 func (im instanceMap) Error() (error, bool) {
 	if err, ok := im[errorKey]; ok {
@@ -0,0 +1,82 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// waitInstanceInterval is the polling interval used to check the state history of a waited job instance.
+const waitInstanceInterval = 100 * time.Millisecond
+
+// waitInstance waits until the job instance with the specified UUID reaches a final state in the state history, and returns the instance rebuilt from the history.
+// Terminated instances which are still retriable are not regarded as final because they will be processed again.
+// If the state history has no records of the job instance, it returns ErrNotFound instead of waiting.
+func waitInstance(ctx context.Context, history StateHistory, uuid UUID, codec ResultCodec) (Instance, error) {
+	query := NewQuery(WithQueryUUID(uuid))
+	for {
+		records, err := history.LookupHistory(query)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			return nil, fmt.Errorf("job instance %s is %w", uuid, ErrNotFound)
+		}
+		lastState := records.LastState()
+		if lastState != nil && lastState.State().Is(JobStateFinal) {
+			instances, err := newInstancesFromHistory(records, codec)
+			if err != nil {
+				return nil, err
+			}
+			for _, ji := range instances {
+				if ji.UUID() != uuid {
+					continue
+				}
+				if ji.State() == JobTerminated && ji.IsRetriable() {
+					break
+				}
+				return ji, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(waitInstanceInterval):
+		}
+	}
+}
+
+// newResultSetFromInstance returns the result set and error of the specified job instance in a final state.
+func newResultSetFromInstance(ji Instance) (ResultSet, error) {
+	switch ji.State() {
+	case JobCanceled:
+		return nil, fmt.Errorf("job instance %s is %w", ji.UUID(), ErrCanceled)
+	case JobTimedOut:
+		return nil, fmt.Errorf("job instance %s is %w", ji.UUID(), ErrTimedOut)
+	}
+	return ji.ResultSet()
+}
+
+// Await waits until the job instance reaches a final state, and returns the result set and error of the instance.
+// If the job instance is canceled or timed out, it returns an error which wraps ErrCanceled or ErrTimedOut.
+func (ji *jobInstance) Await(ctx context.Context) (ResultSet, error) {
+	rji, err := waitInstance(ctx, ji.history, ji.uuid, ji.resultCodec)
+	if err != nil {
+		return nil, err
+	}
+	return newResultSetFromInstance(rji)
+}
@@ -18,9 +18,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	logger "github.com/cybergarage/go-logger/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Manager is an interface that defines methods for managing jobs.
@@ -42,6 +47,7 @@ type Manager interface {
 	// It creates a new job instance and enqueues it in the job queue.
 	// If no schedule option is set, the job instance will be scheduled to run immediately by default.
 	// If the specified job is not registered, the manager will register the job automatically.
+	// If a context.Context is given as an option, the span in the context is the parent of the trace of the job instance.
 	ScheduleJob(job Job, opts ...any) (Instance, error)
 	// ScheduleRegisteredJob schedules a registered job by its kind with the given options.
 	// If the job is not registered, an error will be returned.
@@ -52,23 +58,41 @@ type Manager interface {
 	EnqueueInstance(job Instance) error
 	// DequeueNextInstance returns the next scheduled job instance and dequeues it from the job queue.
 	DequeueNextInstance() (Instance, error)
-	// LookupInstances looks up all job instances which match the specified query.
+	// LookupInstances looks up all job instances which match the specified query, sorted by their creation times in the order of the query and limited to the page of the query.
 	LookupInstances(query Query) ([]Instance, error)
 	// CancelInstances cancels all job instances which match the specified query.
 	CancelInstances(query Query) ([]Instance, error)
+	// WaitInstance waits until the job instance with the specified UUID reaches a final state, and returns the result set and error of the instance.
+	// If the job instance is canceled or timed out, it returns an error which wraps ErrCanceled or ErrTimedOut.
+	WaitInstance(ctx context.Context, uuid UUID) (ResultSet, error)
 	// ListInstances returns all job instances which are currently scheduled, processing, completed, or terminated after the manager started.
 	ListInstances() ([]Instance, error)
+	// Stats returns the aggregate statistics of the job instances by kind and state, the queue depth, the oldest scheduled time, the worker utilization,
+	// and the success and failure rates of the executions within the window set by WithStatsWindow.
+	Stats(opts ...StatsOption) (Stats, error)
 
-	// LookupHistory retrieves all state records for a job instance, sorted by timestamp.
+	// LookupHistory retrieves all state records for a job instance, sorted by timestamp in the order of the query and limited to the page of the query.
 	LookupInstanceHistory(query Query) (InstanceHistory, error)
 	// ClearInstanceHistory clears all state records for a job instance that match the specified filter.
 	ClearInstanceHistory(filter Filter) error
 
-	// LookupLogs retrieves all logs for a job instance.
+	// LookupLogs retrieves all logs for a job instance, sorted by timestamp in the order of the query and limited to the page of the query.
 	LookupInstanceLogs(query Query) ([]Log, error)
 	// ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
 	ClearInstanceLogs(filter Filter) error
 
+	// LookupAuditRecords retrieves all audit records of administrative operations which match the specified query, sorted by timestamp in the order of the query and limited to the page of the query.
+	LookupAuditRecords(query Query) ([]AuditRecord, error)
+	// ClearAuditRecords clears all audit records that match the specified filter.
+	ClearAuditRecords(filter Filter) error
+
+	// AddSubscription adds a webhook subscription which is notified of state changes of job instances matching the subscription.
+	AddSubscription(subscription Subscription) error
+	// RemoveSubscription removes the webhook subscription with the specified identifier.
+	RemoveSubscription(id UUID) error
+	// ListSubscriptions returns all webhook subscriptions, sorted by creation time.
+	ListSubscriptions() ([]Subscription, error)
+
 	// Workers returns a list of all workers in the group.
 	Workers() []Worker
 	// ResizeWorkers scales the number of workers in the group.
@@ -80,6 +104,9 @@ type Manager interface {
 	Start() error
 	// Stop stops the job manager.
 	Stop() error
+	// Ready returns nil if the job manager is ready to process jobs. Otherwise, it returns an error which wraps ErrNotReady,
+	// such as when the store couldn't be started or doesn't respond to the ping, or the workers are not running.
+	Ready(ctx context.Context) error
 	// Wait waits for all scheduled jobs to complete or terminate.
 	Wait(ctx context.Context) error
 	// Clear clears all jobs and history from the job manager without registered jobs.
@@ -90,7 +117,20 @@ type manager struct {
 	*workerGroup
 	repository
 
-	store Store
+	store               Store
+	storeStarted        atomic.Bool
+	resultCodec         ResultCodec
+	blobStore           BlobStore
+	claimCheckThreshold int
+	claimChecker        *claimChecker
+	auditActor          string
+	notifier            *notifier
+	hooks               *hooks
+	tracing             *tracing
+	metricsRegisterer   prometheus.Registerer
+	metricsNamespace    string
+	metricsConstLabels  map[string]string
+	metrics             *metrics
 }
 
 // ManagerOption is a function that configures a job manager.
@@ -103,6 +143,57 @@ func WithStore(store Store) ManagerOption {
 	}
 }
 
+// WithResultCodec sets the codec used to record result sets in the job history.
+func WithResultCodec(codec ResultCodec) ManagerOption {
+	return func(m *manager) {
+		m.resultCodec = codec
+	}
+}
+
+// WithBlobStore sets the blob store to which the manager offloads large arguments of job instances.
+// Offloaded arguments are replaced with claim check references, fetched again before execution,
+// and deleted when the history of the job instances is cleared.
+func WithBlobStore(store BlobStore) ManagerOption {
+	return func(m *manager) {
+		m.blobStore = store
+	}
+}
+
+// WithClaimCheckThreshold sets the size in bytes of JSON-encoded arguments above which the arguments are offloaded to the blob store.
+func WithClaimCheckThreshold(size int) ManagerOption {
+	return func(m *manager) {
+		m.claimCheckThreshold = size
+	}
+}
+
+// WithAuditActor sets the actor recorded in the audit log for operations made through the manager.
+func WithAuditActor(actor string) ManagerOption {
+	return func(m *manager) {
+		m.auditActor = actor
+	}
+}
+
+// WithNotificationClient sets the HTTP client used to deliver notifications to webhook subscriptions.
+func WithNotificationClient(client *http.Client) ManagerOption {
+	return func(m *manager) {
+		m.notifier.client = client
+	}
+}
+
+// WithNotificationMaxAttempts sets the maximum number of delivery attempts of a notification to a webhook subscription.
+func WithNotificationMaxAttempts(attempts int) ManagerOption {
+	return func(m *manager) {
+		m.notifier.maxAttempts = attempts
+	}
+}
+
+// WithNotificationBackoff sets the backoff strategy between delivery attempts of a notification to a webhook subscription.
+func WithNotificationBackoff(fn NotificationBackoffStrategy) ManagerOption {
+	return func(m *manager) {
+		m.notifier.backoff = fn
+	}
+}
+
 // NewManager creates a new instance of the job manager.
 func NewManager(opts ...any) (Manager, error) {
 	return newManager(opts...)
@@ -111,9 +202,22 @@ func NewManager(opts ...any) (Manager, error) {
 // NewManager creates a new instance of the job manager.
 func newManager(opts ...any) (*manager, error) {
 	mgr := &manager{
-		store:       NewLocalStore(),
-		workerGroup: newWorkerGroup(WithNumWorkers(DefaultWorkerNum)),
-		repository:  nil,
+		store:               NewLocalStore(),
+		storeStarted:        atomic.Bool{},
+		resultCodec:         NewJSONResultCodec(),
+		blobStore:           nil,
+		claimCheckThreshold: DefaultClaimCheckThreshold,
+		claimChecker:        nil,
+		auditActor:          DefaultAuditActor,
+		notifier:            newNotifier(),
+		hooks:               newHooks(),
+		tracing:             newTracing(),
+		metricsRegisterer:   nil,
+		metricsNamespace:    DefaultMetricsNamespace,
+		metricsConstLabels:  nil,
+		metrics:             nil,
+		workerGroup:         newWorkerGroup(WithNumWorkers(DefaultWorkerNum)),
+		repository:          nil,
 	}
 
 	for _, opt := range opts {
@@ -127,10 +231,23 @@ func newManager(opts ...any) (*manager, error) {
 		}
 	}
 
+	if mgr.blobStore != nil {
+		mgr.claimChecker = newClaimChecker(mgr.blobStore, mgr.claimCheckThreshold)
+	}
+
+	metrics, err := newMetrics(mgr)
+	if err != nil {
+		return nil, err
+	}
+	mgr.metrics = metrics
+	mgr.store = newMeteredStore(mgr.store, mgr.metrics)
+	mgr.notifier.store = mgr.store
 	mgr.repository = newRepository(
 		withRepositoryStore(mgr.store),
+		withRepositoryStateListener(mgr.notifier.notify),
 	)
 	withWorkerGroupManager(mgr)(mgr.workerGroup)
+	withWorkerGroupMetrics(mgr.metrics)(mgr.workerGroup)
 
 	return mgr, nil
 }
@@ -143,10 +260,25 @@ func (mgr *manager) Store() Store {
 // RegisterJob registers a job in the registry. If a job with the same kind is already registered,
 // it will be overwritten with the new job.
 func (mgr *manager) RegisterJob(job Job) error {
+	return mgr.registerJob(mgr.auditActor, job)
+}
+
+func (mgr *manager) registerJob(actor string, job Job) error {
 	err := mgr.repository.RegisterJob(job)
 	if err != nil {
 		return fmt.Errorf("failed to register job: %w", err)
 	}
+	mgr.audit(actor, AuditRegisterJob, WithAuditRecordKind(job.Kind()))
+	return nil
+}
+
+// UnregisterJob removes a job from the registry by its kind.
+func (mgr *manager) UnregisterJob(kind Kind) error {
+	err := mgr.repository.UnregisterJob(kind)
+	if err != nil {
+		return err
+	}
+	mgr.audit(mgr.auditActor, AuditUnregisterJob, WithAuditRecordKind(kind))
 	return nil
 }
 
@@ -155,21 +287,42 @@ func (mgr *manager) RegisterJob(job Job) error {
 // It creates a new job instance and enqueues it in the job queue.
 // If the schedule option is not set, the job instance will be scheduled to run immediately as default.
 func (mgr *manager) ScheduleRegisteredJob(kind Kind, opts ...any) (Instance, error) {
+	return mgr.scheduleRegisteredJob(mgr.auditActor, kind, opts...)
+}
+
+func (mgr *manager) scheduleRegisteredJob(actor string, kind Kind, opts ...any) (Instance, error) {
 	job, ok := mgr.LookupJob(kind)
 	if !ok {
 		return nil, fmt.Errorf("registered job not found: %s", kind)
 	}
-	return mgr.ScheduleJob(job, opts...)
+	return mgr.scheduleJob(actor, job, opts...)
 }
 
 // ScheduleJob schedules a job instance with the given job and options.
 // It creates a new job instance and enqueues it in the job queue.
 // If no schedule option is set, the job instance will be scheduled to run immediately by default.
 // If the specified job is not registered, the manager will register the job automatically.
+// If a context.Context is given as an option, the span in the context is the parent of the trace of the job instance.
 func (mgr *manager) ScheduleJob(job Job, opts ...any) (Instance, error) {
+	return mgr.scheduleJob(mgr.auditActor, job, opts...)
+}
+
+func (mgr *manager) scheduleJob(actor string, job Job, opts ...any) (Instance, error) {
+	// A context among the options is the parent of the trace of the job instance.
+	ctx := context.Background()
+	instanceOpts := make([]any, 0, len(opts))
+	for _, opt := range opts {
+		if c, ok := opt.(context.Context); ok {
+			ctx = c
+			continue
+		}
+		instanceOpts = append(instanceOpts, opt)
+	}
+	opts = instanceOpts
+
 	_, ok := mgr.LookupJob(job.Kind())
 	if !ok {
-		err := mgr.RegisterJob(job)
+		err := mgr.registerJob(actor, job)
 		if err != nil {
 			return nil, err
 		}
@@ -178,30 +331,60 @@ func (mgr *manager) ScheduleJob(job Job, opts ...any) (Instance, error) {
 	jobOpts := []any{
 		WithJob(job),
 		WithInstanceHistory(mgr.repository),
+		withInstanceResultCodec(mgr.resultCodec),
+		withInstanceClaimChecker(mgr.claimChecker),
+		withInstanceHooks(mgr.hooks),
+		withInstanceTracing(mgr.tracing),
+		withInstanceMetrics(mgr.metrics),
 	}
 	jobOpts = append(jobOpts, opts...)
 	ji, err := NewInstance(jobOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if err := ji.UpdateState(JobCreated, opts...); err != nil {
-		return nil, err
-	}
 
-	if err := mgr.ScheduleJobInstance(ji); err != nil {
-		return nil, err
-	}
-	if err := ji.UpdateState(JobScheduled, opts...); err != nil {
+	ctx, span := mgr.tracing.start(ctx, TraceSpanEnqueue, ji, trace.WithSpanKind(trace.SpanKindProducer))
+	err = mgr.enqueueCreatedInstance(ctx, ji, opts...)
+	mgr.tracing.end(span, err)
+	if err != nil {
 		return nil, err
 	}
 
-	mQueuedJobs.WithLabelValues(ji.Kind()).Inc()
+	mgr.metrics.queued.WithLabelValues(ji.Kind()).Inc()
+
+	mgr.hooks.schedule(ji)
+
+	mgr.audit(actor, AuditScheduleJob, WithAuditRecordKind(ji.Kind()), WithAuditRecordUUIDs(ji.UUID()))
 
 	return ji, nil
 }
 
+// enqueueCreatedInstance stores the trace context of the specified context in the created job instance, and enqueues it in the job queue.
+func (mgr *manager) enqueueCreatedInstance(ctx context.Context, ji Instance, opts ...any) error {
+	if jiImpl, ok := ji.(*jobInstance); ok {
+		mgr.tracing.inject(ctx, jiImpl)
+		jiImpl.enqueuedAt = time.Now()
+		if mgr.claimChecker != nil {
+			if err := mgr.claimChecker.offload(ctx, jiImpl); err != nil {
+				return err
+			}
+		}
+	}
+	if err := ji.UpdateState(JobCreated, opts...); err != nil {
+		return err
+	}
+
+	if err := mgr.ScheduleJobInstance(ji); err != nil {
+		return err
+	}
+	return ji.UpdateState(JobScheduled, opts...)
+}
+
 // EnqueueInstance enqueues a job instance in the job queue.
 func (mgr *manager) EnqueueInstance(job Instance) error {
+	if jiImpl, ok := job.(*jobInstance); ok {
+		jiImpl.enqueuedAt = time.Now()
+	}
 	return mgr.Queue().Enqueue(context.Background(), job)
 }
 
@@ -217,6 +400,7 @@ func (mgr *manager) DequeueNextInstance() (Instance, error) {
 	// If the instance has a executor handler, it means it was dequeued from the local store.
 
 	if instance.Executor() != nil {
+		mgr.hooks.dequeue(instance)
 		return instance, nil
 	}
 
@@ -236,55 +420,192 @@ func (mgr *manager) DequeueNextInstance() (Instance, error) {
 	}
 
 	// Recreate the instance with the corresponding job information, including the handler's executor.
+	var traceContext map[string]string
+	var enqueuedAt time.Time
+	if jiImpl, ok := instance.(*jobInstance); ok {
+		traceContext = jiImpl.traceContext
+		enqueuedAt = jiImpl.enqueuedAt
+	}
 	newInstance, err := NewInstance(
 		WithJob(job),
 		WithUUID(instance.UUID()),
 		WithCreatedAt(instance.CreatedAt()),
 		WithState(instance.State()),
 		WithArguments(instance.Arguments()...),
-		withInstanceStore(mgr.store),
+		WithNamedArguments(instance.NamedArguments()),
+		WithInstanceHistory(mgr.repository),
+		withInstanceResultCodec(mgr.resultCodec),
+		withInstanceClaimChecker(mgr.claimChecker),
+		withInstanceHooks(mgr.hooks),
+		withInstanceTracing(mgr.tracing),
+		withInstanceMetrics(mgr.metrics),
+		withInstanceTraceContext(traceContext),
+		withInstanceEnqueuedAt(enqueuedAt),
 	)
 	if err != nil {
 		return nil, err
 	}
+	mgr.hooks.dequeue(newInstance)
 	return newInstance, nil
 }
 
-// LookupInstances looks up all job instances which match the specified query.
+// LookupInstances looks up all job instances which match the specified query, sorted by their creation times in the order of the query and limited to the page of the query.
 func (mgr *manager) LookupInstances(query Query) ([]Instance, error) {
-	matchedInstances := []Instance{}
+	if _, ok := query.Limit(); ok {
+		return mgr.lookupInstancePage(query)
+	}
+
+	pager := NewPager[Instance](query)
 
 	allQueueInstances, err := newInstancesFromQueue(mgr.Queue())
 	if err != nil {
 		return nil, err
 	}
 	for _, queueInstance := range allQueueInstances {
-		if !query.Matches(queueInstance) {
-			continue
-		}
-		matchedInstances = append(matchedInstances, queueInstance)
+		pager.Add(queueInstance)
 	}
 
-	history, err := mgr.LookupHistory(query)
+	// The job instances are restored from their whole history, and then the page is applied to the instances.
+	history, err := mgr.LookupHistory(newQueryCriteriaFrom(query))
 	if err != nil {
 		return nil, err
 	}
-	historyInstances, err := newInstancesFromHistory(history)
+	historyInstances, err := newInstancesFromHistory(history, mgr.resultCodec)
 	if err != nil {
 		return nil, err
 	}
 	for _, historyInstance := range historyInstances {
-		if !query.Matches(historyInstance) {
-			continue
+		pager.Add(historyInstance)
+	}
+
+	return pager.Page(), nil
+}
+
+// lookupInstancePage looks up the page of the job instances which match the specified query with a limit.
+// The created state records, which are positioned as the job instances, are looked up in the order of the query batch by batch,
+// and the job instances of each batch are restored from one history scan only until the page is filled, so that the whole history is never restored.
+func (mgr *manager) lookupInstancePage(query Query) ([]Instance, error) {
+	pager := NewPager[Instance](query)
+
+	limit, _ := query.Limit()
+	opts := []QueryOption{
+		WithQueryState(JobCreated),
+		WithQueryOrder(query.Order()),
+		WithQueryLimit(query.Offset() + limit),
+	}
+	if uuid, ok := query.UUID(); ok {
+		opts = append(opts, WithQueryUUID(uuid))
+	}
+	if kind, ok := query.Kind(); ok {
+		opts = append(opts, WithQueryKind(kind))
+	}
+	if before, ok := query.Before(); ok {
+		opts = append(opts, WithQueryBefore(before))
+	}
+	if after, ok := query.After(); ok {
+		opts = append(opts, WithQueryAfter(after))
+	}
+	if token, ok := query.PageToken(); ok {
+		opts = append(opts, WithQueryPageToken(token))
+	}
+
+	for {
+		createdQuery := NewQuery(opts...)
+		createdRecords, err := mgr.LookupHistory(createdQuery)
+		if err != nil {
+			return nil, err
+		}
+		instances, err := mgr.restoreInstances(query, createdRecords)
+		if err != nil {
+			return nil, err
 		}
-		matchedInstances = append(matchedInstances, historyInstance)
+		for _, ji := range instances {
+			pager.Add(ji)
+			if pager.IsFull() {
+				return pager.Page(), nil
+			}
+		}
+		token, ok := NextPageToken(createdQuery, createdRecords)
+		if !ok {
+			return pager.Page(), nil
+		}
+		opts = append(opts, WithQueryPageToken(token))
 	}
+}
 
-	return matchedInstances, nil
+// restoreInstances restores the job instances of the specified created state records in the order of the records.
+// The state records of the job instances are looked up by one history scan since the earliest creation of them.
+func (mgr *manager) restoreInstances(query Query, createdRecords InstanceHistory) ([]Instance, error) {
+	if len(createdRecords) == 0 {
+		return []Instance{}, nil
+	}
+	uuids := map[UUID]bool{}
+	since := createdRecords[0].Timestamp()
+	for _, createdRecord := range createdRecords {
+		uuids[createdRecord.UUID()] = true
+		if createdRecord.Timestamp().Before(since) {
+			since = createdRecord.Timestamp()
+		}
+	}
+	opts := []QueryOption{
+		WithQueryAfter(since.Add(-time.Nanosecond)),
+	}
+	if uuid, ok := query.UUID(); ok {
+		opts = append(opts, WithQueryUUID(uuid))
+	}
+	if kind, ok := query.Kind(); ok {
+		opts = append(opts, WithQueryKind(kind))
+	}
+	records, err := mgr.LookupHistory(NewQuery(opts...))
+	if err != nil {
+		return nil, err
+	}
+	history := InstanceHistory{}
+	for _, record := range records {
+		if uuids[record.UUID()] {
+			history = append(history, record)
+		}
+	}
+	historyInstances, err := newInstancesFromHistory(history, mgr.resultCodec)
+	if err != nil {
+		return nil, err
+	}
+	instanceMap := map[UUID]Instance{}
+	for _, ji := range historyInstances {
+		instanceMap[ji.UUID()] = ji
+	}
+	instances := make([]Instance, 0, len(createdRecords))
+	for _, createdRecord := range createdRecords {
+		if ji, ok := instanceMap[createdRecord.UUID()]; ok {
+			instances = append(instances, ji)
+		}
+	}
+	return instances, nil
 }
 
 // CancelInstances cancels all job instances which match the specified query.
 func (mgr *manager) CancelInstances(query Query) ([]Instance, error) {
+	return mgr.cancelInstances(mgr.auditActor, query)
+}
+
+func (mgr *manager) cancelInstances(actor string, query Query) ([]Instance, error) {
+	canceledInstances, err := mgr.cancelMatchedInstances(query)
+	uuids := make([]UUID, len(canceledInstances))
+	for n, ji := range canceledInstances {
+		uuids[n] = ji.UUID()
+	}
+	opts := []AuditRecordOption{
+		WithAuditRecordQuery(newAuditQueryString(query)),
+		WithAuditRecordUUIDs(uuids...),
+	}
+	if kind, ok := query.Kind(); ok {
+		opts = append(opts, WithAuditRecordKind(kind))
+	}
+	mgr.audit(actor, AuditCancelInstances, opts...)
+	return canceledInstances, err
+}
+
+func (mgr *manager) cancelMatchedInstances(query Query) ([]Instance, error) {
 	canceledInstances := []Instance{}
 
 	allQueueInstances, err := newInstancesFromQueue(mgr.Queue())
@@ -307,7 +628,7 @@ func (mgr *manager) CancelInstances(query Query) ([]Instance, error) {
 
 	for _, worker := range mgr.Workers() {
 		workerInstance, ok := worker.ProcessingInstance()
-		if !ok {
+		if !ok || !query.Matches(workerInstance) {
 			continue
 		}
 		if err := worker.Cancel(); err != nil {
@@ -319,6 +640,21 @@ func (mgr *manager) CancelInstances(query Query) ([]Instance, error) {
 	return canceledInstances, nil
 }
 
+// WaitInstance waits until the job instance with the specified UUID reaches a final state, and returns the result set and error of the instance.
+// If the job instance is canceled or timed out, it returns an error which wraps ErrCanceled or ErrTimedOut.
+func (mgr *manager) WaitInstance(ctx context.Context, uuid UUID) (ResultSet, error) {
+	ji, err := mgr.waitInstance(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return newResultSetFromInstance(ji)
+}
+
+// waitInstance waits until the job instance with the specified UUID reaches a final state, and returns the instance rebuilt from the history.
+func (mgr *manager) waitInstance(ctx context.Context, uuid UUID) (Instance, error) {
+	return waitInstance(ctx, mgr.repository, uuid, mgr.resultCodec)
+}
+
 // ListInstances returns all job instances which are currently scheduled, processing, completed, or terminated after the manager started.
 func (mgr *manager) ListInstances() ([]Instance, error) {
 	return mgr.LookupInstances(NewQuery())
@@ -331,7 +667,17 @@ func (mgr *manager) LookupInstanceHistory(query Query) (InstanceHistory, error)
 
 // ClearInstanceHistory clears all state records for a job instance that match the specified filter.
 func (mgr *manager) ClearInstanceHistory(filter Filter) error {
-	return mgr.repository.ClearHistory(filter)
+	var err error
+	if mgr.claimChecker == nil {
+		err = mgr.repository.ClearHistory(filter)
+	} else {
+		err = mgr.claimChecker.clearHistory(context.Background(), mgr.repository, mgr.Queue(), filter)
+	}
+	if err != nil {
+		return err
+	}
+	mgr.audit(mgr.auditActor, AuditClearHistory, WithAuditRecordQuery(newAuditFilterString(filter)))
+	return nil
 }
 
 // LookupLogs retrieves all logs for a job instance.
@@ -341,13 +687,89 @@ func (mgr *manager) LookupInstanceLogs(query Query) ([]Log, error) {
 
 // ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
 func (mgr *manager) ClearInstanceLogs(filter Filter) error {
-	return mgr.repository.ClearLogs(filter)
+	err := mgr.repository.ClearLogs(filter)
+	if err != nil {
+		return err
+	}
+	mgr.audit(mgr.auditActor, AuditClearLogs, WithAuditRecordQuery(newAuditFilterString(filter)))
+	return nil
+}
+
+// LookupAuditRecords retrieves all audit records of administrative operations which match the specified query, sorted by timestamp in the order of the query and limited to the page of the query.
+func (mgr *manager) LookupAuditRecords(query Query) ([]AuditRecord, error) {
+	return mgr.store.LookupAuditRecords(context.Background(), query)
+}
+
+// ClearAuditRecords clears all audit records that match the specified filter.
+func (mgr *manager) ClearAuditRecords(filter Filter) error {
+	return mgr.store.ClearAuditRecords(context.Background(), filter)
+}
+
+// AddSubscription adds a webhook subscription which is notified of state changes of job instances matching the subscription.
+func (mgr *manager) AddSubscription(subscription Subscription) error {
+	return mgr.addSubscription(mgr.auditActor, subscription)
+}
+
+func (mgr *manager) addSubscription(actor string, subscription Subscription) error {
+	err := mgr.store.AddSubscription(context.Background(), subscription)
+	if err != nil {
+		return err
+	}
+	mgr.notifier.invalidate()
+	mgr.audit(actor, AuditAddSubscription, WithAuditRecordKind(subscription.Kind()), WithAuditRecordQuery(subscription.String()))
+	return nil
+}
+
+// RemoveSubscription removes the webhook subscription with the specified identifier.
+func (mgr *manager) RemoveSubscription(id UUID) error {
+	return mgr.removeSubscription(mgr.auditActor, id)
+}
+
+func (mgr *manager) removeSubscription(actor string, id UUID) error {
+	err := mgr.store.RemoveSubscription(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	mgr.notifier.invalidate()
+	mgr.audit(actor, AuditRemoveSubscription, WithAuditRecordQuery(id.String()))
+	return nil
+}
+
+// ListSubscriptions returns all webhook subscriptions, sorted by creation time.
+func (mgr *manager) ListSubscriptions() ([]Subscription, error) {
+	subs, err := mgr.store.ListSubscriptions(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt().Before(subs[j].CreatedAt())
+	})
+	return subs, nil
+}
+
+// audit records an administrative operation by the actor in the audit log.
+// Failures to record are logged and do not fail the operation.
+func (mgr *manager) audit(actor string, operation AuditOperation, opts ...AuditRecordOption) {
+	opts = append([]AuditRecordOption{
+		WithAuditRecordActor(actor),
+		WithAuditRecordOperation(operation),
+	}, opts...)
+	record := NewAuditRecord(opts...)
+	if err := mgr.store.LogAuditRecord(context.Background(), record); err != nil {
+		logger.Warnf("failed to record audit (%s): %s", record, err)
+	}
 }
 
 // Start starts the job manager.
 func (mgr *manager) Start() error {
+	startStore := func() error {
+		err := mgr.store.Start()
+		mgr.storeStarted.Store(err == nil)
+		return err
+	}
 	starters := []func() error{
-		mgr.store.Start,
+		startStore,
+		mgr.notifier.Start,
 		mgr.workerGroup.Start,
 	}
 	var errs error
@@ -361,9 +783,14 @@ func (mgr *manager) Start() error {
 
 // Stop stops the job manager.
 func (mgr *manager) Stop() error {
+	stopStore := func() error {
+		mgr.storeStarted.Store(false)
+		return mgr.store.Stop()
+	}
 	stoppers := []func() error{
-		mgr.store.Stop,
+		stopStore,
 		mgr.workerGroup.Stop,
+		mgr.notifier.Stop,
 	}
 	var errs error
 	for _, stopper := range stoppers {
@@ -380,11 +807,18 @@ func (mgr *manager) Clear() error {
 		mgr.store.Clear,
 		mgr.repository.Clear,
 	}
+	if mgr.blobStore != nil {
+		cleaners = append(cleaners, func() error {
+			return mgr.blobStore.ClearBlobs(context.Background())
+		})
+	}
 	for _, cleaner := range cleaners {
 		if err := cleaner(); err != nil {
 			return fmt.Errorf("failed to clear job manager: %w", err)
 		}
 	}
+	mgr.notifier.invalidate()
+	mgr.audit(mgr.auditActor, AuditClear)
 	return nil
 }
 
@@ -15,19 +15,23 @@
 package job
 
 const (
-	uuidKey       = "uuid"
-	kindKey       = "kind"
-	timestampKey  = "timestamp"
-	stateKey      = "state"
-	errorKey      = "error"
-	resultSetKey  = "result_set"
-	argumentsKey  = "arguments"
-	maxRetriesKey = "max_retries"
-	priorityKey   = "priority"
-	timeoutKey    = "timeout"
-	levelKey      = "level"
-	messageKey    = "message"
-	crontabKey    = "crontab"
-	scheduleAtKey = "schedule_at"
-	descKey       = "description"
+	uuidKey           = "uuid"
+	kindKey           = "kind"
+	timestampKey      = "timestamp"
+	stateKey          = "state"
+	errorKey          = "error"
+	resultSetKey      = "result_set"
+	resultCodecKey    = "result_codec"
+	argumentsKey      = "arguments"
+	namedArgumentsKey = "named_arguments"
+	maxRetriesKey     = "max_retries"
+	priorityKey       = "priority"
+	timeoutKey        = "timeout"
+	levelKey          = "level"
+	messageKey        = "message"
+	crontabKey        = "crontab"
+	scheduleAtKey     = "schedule_at"
+	descKey           = "description"
+	traceContextKey   = "trace_context"
+	enqueuedAtKey     = "enqueued_at"
 )
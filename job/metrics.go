@@ -15,138 +15,421 @@
 package job
 
 import (
+	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const (
-	labelKind = "kind"
+	// DefaultMetricsNamespace is the default namespace prefixed to the metric names.
+	DefaultMetricsNamespace = "go_job"
 )
 
-var (
-	// Current number of registered jobs.
-	mRegisteredJobs = prometheus.NewGauge(
-		prometheus.GaugeOpts{ // nolint: exhaustruct
-			Name: "go_job_registered",
-			Help: "Current number of registered jobs",
-		})
+const (
+	labelKind      = "kind"
+	labelStore     = "store"
+	labelOperation = "operation"
+)
 
-	// Current number of queued jobs by kind.
-	mQueuedJobs = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{ // nolint: exhaustruct
-			Name: "go_job_queued",
-			Help: "Current number of queued jobs by kind",
-		},
-		[]string{labelKind},
-	)
+// WithMetricsRegisterer sets the Prometheus registerer with which the metrics of the manager are registered.
+// By default, the metrics are registered with a new registry of the manager, which is served by the metrics server of the job server.
+// The metrics server serves the registerer only if it is also a prometheus.Gatherer such as *prometheus.Registry.
+func WithMetricsRegisterer(registerer prometheus.Registerer) ManagerOption {
+	return func(m *manager) {
+		m.metricsRegisterer = registerer
+	}
+}
 
-	// Total number of executed jobs by kind.
-	mExecutedJobs = prometheus.NewCounterVec(
-		prometheus.CounterOpts{ // nolint: exhaustruct
-			Name: "go_job_executed_total",
-			Help: "Total number of executed jobs by kind",
-		},
-		[]string{labelKind},
-	)
+// WithMetricsNamespace sets the namespace prefixed to the metric names. The default namespace is DefaultMetricsNamespace,
+// and no namespace is prefixed if the namespace is empty.
+func WithMetricsNamespace(namespace string) ManagerOption {
+	return func(m *manager) {
+		m.metricsNamespace = namespace
+	}
+}
 
-	// Total number of successfully completed jobs by kind.
-	mCompletedJobs = prometheus.NewCounterVec(
-		prometheus.CounterOpts{ // nolint: exhaustruct
-			Name: "go_job_completed_total",
-			Help: "Total number of successfully completed jobs by kind",
-		},
-		[]string{labelKind},
-	)
+// WithMetricsConstLabels sets the constant labels added to all metrics of the manager, such as the node and the cluster,
+// to distinguish the metrics of managers registered with the same registerer.
+func WithMetricsConstLabels(labels map[string]string) ManagerOption {
+	return func(m *manager) {
+		m.metricsConstLabels = labels
+	}
+}
 
-	// Total number of terminated jobs by kind.
-	mTerminatedJobs = prometheus.NewCounterVec(
-		prometheus.CounterOpts{ // nolint: exhaustruct
-			Name: "go_job_terminated_total",
-			Help: "Total number of terminated jobs by kind",
-		},
-		[]string{labelKind},
-	)
+// metrics holds the Prometheus metrics of a manager.
+// All methods are safe to call on a nil metrics, so that job instances created without a manager are not measured.
+type metrics struct {
+	gatherer prometheus.Gatherer
 
+	// Current number of queued jobs by kind.
+	queued *prometheus.GaugeVec
+	// Total number of executed jobs by kind.
+	executed *prometheus.CounterVec
+	// Total number of successfully completed jobs by kind.
+	completed *prometheus.CounterVec
+	// Total number of terminated jobs by kind.
+	terminated *prometheus.CounterVec
 	// Total number of canceled jobs by kind.
-	mCanceledJobs = prometheus.NewCounterVec(
-		prometheus.CounterOpts{ // nolint: exhaustruct
-			Name: "go_job_canceled_total",
-			Help: "Total number of canceled jobs by kind",
-		},
-		[]string{labelKind},
-	)
-
+	canceled *prometheus.CounterVec
 	// Total number of timed out jobs by kind.
-	mTimedOutJobs = prometheus.NewCounterVec(
-		prometheus.CounterOpts{ // nolint: exhaustruct
-			Name: "go_job_timedout_total",
-			Help: "Total number of timed out jobs by kind",
-		},
-		[]string{labelKind},
-	)
-
+	timedOut *prometheus.CounterVec
+	// Total number of panicked job executions by kind.
+	panicked *prometheus.CounterVec
 	// Histogram of job execution durations in seconds, labeled by job type.
-	mJobDuration = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{ // nolint: exhaustruct
-			Name:    "go_job_duration_seconds",
-			Help:    "Histogram of job execution durations in seconds by job type",
-			Buckets: prometheus.DefBuckets,
-		},
-		[]string{labelKind},
-	)
-
-	// Current number of workers.
-	mWorkers = prometheus.NewGauge(prometheus.GaugeOpts{ // nolint: exhaustruct
-		Name: "go_job_workers",
-		Help: "Current number of workers",
-	})
-)
+	duration *prometheus.HistogramVec
+	// Histogram of times job instances waited in the queue until dequeued in seconds, labeled by job type.
+	queueWait *prometheus.HistogramVec
+	// Total number of retried jobs by kind.
+	retried *prometheus.CounterVec
+	// Current number of processing jobs by kind.
+	processing *prometheus.GaugeVec
+	// Histogram of store operation durations in seconds, labeled by store name and operation.
+	storeOperationDuration *prometheus.HistogramVec
+}
 
-func init() { // Register all metrics with Prometheus
-	prometheus.MustRegister(
-		mRegisteredJobs,
-		mQueuedJobs,
-		mExecutedJobs,
-		mCompletedJobs,
-		mTerminatedJobs,
-		mCanceledJobs,
-		mTimedOutJobs,
-		mJobDuration,
-		mWorkers,
-	)
+// newMetrics creates the metrics of the manager, and registers them with the registerer of the manager.
+// The registerer is wrapped to prefix the namespace and add the constant labels to the metrics,
+// including the metrics of the store if the store is a prometheus.Collector.
+func newMetrics(mgr *manager) (*metrics, error) {
+	m := &metrics{
+		gatherer: nil,
+		queued: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{ // nolint: exhaustruct
+				Name: "queued",
+				Help: "Current number of queued jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		executed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "executed_total",
+				Help: "Total number of executed jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		completed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "completed_total",
+				Help: "Total number of successfully completed jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		terminated: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "terminated_total",
+				Help: "Total number of terminated jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		canceled: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "canceled_total",
+				Help: "Total number of canceled jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		timedOut: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "timedout_total",
+				Help: "Total number of timed out jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		panicked: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "panicked_total",
+				Help: "Total number of panicked job executions by kind",
+			},
+			[]string{labelKind},
+		),
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{ // nolint: exhaustruct
+				Name:    "duration_seconds",
+				Help:    "Histogram of job execution durations in seconds by job type",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{labelKind},
+		),
+		queueWait: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{ // nolint: exhaustruct
+				Name:    "queue_wait_seconds",
+				Help:    "Histogram of times job instances waited in the queue until dequeued in seconds by kind",
+				Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+			},
+			[]string{labelKind},
+		),
+		retried: prometheus.NewCounterVec(
+			prometheus.CounterOpts{ // nolint: exhaustruct
+				Name: "retried_total",
+				Help: "Total number of retried jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		processing: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{ // nolint: exhaustruct
+				Name: "processing",
+				Help: "Current number of processing jobs by kind",
+			},
+			[]string{labelKind},
+		),
+		storeOperationDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{ // nolint: exhaustruct
+				Name:    "store_operation_duration_seconds",
+				Help:    "Histogram of store operation durations in seconds by store and operation",
+				Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+			},
+			[]string{labelStore, labelOperation},
+		),
+	}
+
+	mgrCollectors := []prometheus.Collector{
+		// Current number of registered jobs.
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{ // nolint: exhaustruct
+				Name: "registered",
+				Help: "Current number of registered jobs",
+			},
+			func() float64 {
+				jobs, err := mgr.ListJobs()
+				if err != nil {
+					return 0
+				}
+				return float64(len(jobs))
+			}),
+		// Current number of workers.
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{ // nolint: exhaustruct
+				Name: "workers",
+				Help: "Current number of workers",
+			},
+			func() float64 {
+				return float64(mgr.NumWorkers())
+			}),
+		// Age of the oldest job instance waiting in the queue by kind.
+		newQueueAgeCollector(
+			prometheus.NewDesc(
+				"queue_oldest_age_seconds",
+				"Age of the oldest job instance waiting in the queue in seconds by kind",
+				[]string{labelKind},
+				nil,
+			),
+			mgr,
+		),
+		m.queued,
+		m.executed,
+		m.completed,
+		m.terminated,
+		m.canceled,
+		m.timedOut,
+		m.panicked,
+		m.duration,
+		m.queueWait,
+		m.retried,
+		m.processing,
+		m.storeOperationDuration,
+	}
+	if collector, ok := mgr.store.(prometheus.Collector); ok {
+		mgrCollectors = append(mgrCollectors, collector)
+	}
+
+	registerer := mgr.metricsRegisterer
+	if registerer == nil {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}), // nolint: exhaustruct
+		)
+		registerer = registry
+	}
+	if gatherer, ok := registerer.(prometheus.Gatherer); ok {
+		m.gatherer = gatherer
+	}
+	if 0 < len(mgr.metricsConstLabels) {
+		registerer = prometheus.WrapRegistererWith(mgr.metricsConstLabels, registerer)
+	}
+	if 0 < len(mgr.metricsNamespace) {
+		registerer = prometheus.WrapRegistererWithPrefix(mgr.metricsNamespace+"_", registerer)
+	}
+	for n, collector := range mgrCollectors {
+		if err := registerer.Register(collector); err != nil {
+			for _, registered := range mgrCollectors[:n] {
+				registerer.Unregister(registered)
+			}
+			return nil, fmt.Errorf("metrics are not registered: %w", err)
+		}
+	}
+
+	return m, nil
+}
+
+// stateChange counts the job instance of the specified kind which reached the final state.
+func (m *metrics) stateChange(kind Kind, state JobState) {
+	if m == nil {
+		return
+	}
+	switch state {
+	case JobCompleted:
+		m.completed.WithLabelValues(kind).Inc()
+	case JobTerminated:
+		m.terminated.WithLabelValues(kind).Inc()
+	case JobCanceled:
+		m.canceled.WithLabelValues(kind).Inc()
+	case JobTimedOut:
+		m.timedOut.WithLabelValues(kind).Inc()
+	}
+}
+
+// dequeue counts the dequeued job instance of the specified kind, and records the time it waited in the queue since the ready time if known.
+func (m *metrics) dequeue(kind Kind, readyAt time.Time) {
+	if m == nil {
+		return
+	}
+	m.queued.WithLabelValues(kind).Dec()
+	if !readyAt.IsZero() {
+		m.queueWait.WithLabelValues(kind).Observe(time.Since(readyAt).Seconds())
+	}
+}
+
+// startProcessing counts the executed job instance of the specified kind which starts processing.
+func (m *metrics) startProcessing(kind Kind) {
+	if m == nil {
+		return
+	}
+	m.executed.WithLabelValues(kind).Inc()
+	m.processing.WithLabelValues(kind).Inc()
+}
+
+// endProcessing records the duration of the job instance of the specified kind which ends processing.
+func (m *metrics) endProcessing(kind Kind, startedAt time.Time) {
+	if m == nil {
+		return
+	}
+	m.processing.WithLabelValues(kind).Dec()
+	m.duration.WithLabelValues(kind).Observe(time.Since(startedAt).Seconds())
+}
+
+// retry counts the retried job instance of the specified kind.
+func (m *metrics) retry(kind Kind) {
+	if m == nil {
+		return
+	}
+	m.retried.WithLabelValues(kind).Inc()
+}
+
+// storeOperation records the duration of the operation of the specified store since the start time.
+func (m *metrics) storeOperation(store string, operation string, startedAt time.Time) {
+	if m == nil {
+		return
+	}
+	m.storeOperationDuration.WithLabelValues(store, operation).Observe(time.Since(startedAt).Seconds())
+}
+
+// panic counts the panicked execution of the job instance of the specified kind.
+func (m *metrics) panic(kind Kind) {
+	if m == nil {
+		return
+	}
+	m.panicked.WithLabelValues(kind).Inc()
+}
+
+// queueAgeCollector collects the age of the oldest job instance waiting in the queue of the manager.
+// The queue is listed at each scrape, so that the instances enqueued by other nodes of remote stores are also collected.
+type queueAgeCollector struct {
+	desc *prometheus.Desc
+	mgr  *manager
+}
+
+func newQueueAgeCollector(desc *prometheus.Desc, mgr *manager) *queueAgeCollector {
+	return &queueAgeCollector{
+		desc: desc,
+		mgr:  mgr,
+	}
+}
+
+// Describe sends the descriptor of the metric.
+func (c *queueAgeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect sends the age of the oldest waiting job instance for each kind. The registered kinds without waiting instances are reported as zero.
+func (c *queueAgeCollector) Collect(ch chan<- prometheus.Metric) {
+	now := time.Now()
+	ages := map[string]time.Duration{}
+	jobs, err := c.mgr.ListJobs()
+	if err == nil {
+		for _, job := range jobs {
+			ages[job.Kind()] = 0
+		}
+	}
+	instances, err := c.mgr.Queue().List(context.Background())
+	if err == nil {
+		for _, instance := range instances {
+			jiImpl, ok := instance.(*jobInstance)
+			if !ok {
+				continue
+			}
+			readyAt := jiImpl.readyAt()
+			if readyAt.IsZero() || now.Before(readyAt) {
+				continue
+			}
+			if age := now.Sub(readyAt); ages[instance.Kind()] < age {
+				ages[instance.Kind()] = age
+			}
+		}
+	}
+	for kind, age := range ages {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, age.Seconds(), kind)
+	}
 }
 
 type metricsServer struct {
-	// Embed the Prometheus metrics registry.
-	registry   *prometheus.Registry
+	// The Prometheus metrics gatherer of the manager.
+	gatherer   prometheus.Gatherer
 	httpServer *http.Server
 	Addr       string
 }
 
-func newMetricsServer() *metricsServer {
+func newMetricsServer(gatherer prometheus.Gatherer) *metricsServer {
+	if gatherer == nil {
+		gatherer = prometheus.Gatherers{}
+	}
 	return &metricsServer{
-		registry:   prometheus.NewRegistry(),
+		gatherer:   gatherer,
 		httpServer: nil,
 		Addr:       "",
 	}
 }
 
-func (ms *metricsServer) Start(port int) error {
+// Start starts the metrics server. The specified handlers, such as the HTTP/JSON gateway, the dashboard and the health endpoints, are served under their paths,
+// and the metrics are served under the other paths.
+func (ms *metricsServer) Start(port int, handlers map[string]http.Handler) error {
 	err := ms.Stop()
 	if err != nil {
 		return err
 	}
 
+	var handler http.Handler = promhttp.HandlerFor(ms.gatherer, promhttp.HandlerOpts{}) // nolint: exhaustruct
+	if 0 < len(handlers) {
+		mux := http.NewServeMux()
+		mux.Handle("/", handler)
+		for path, h := range handlers {
+			mux.Handle(path, h)
+		}
+		handler = mux
+	}
+
 	addr := net.JoinHostPort(ms.Addr, strconv.Itoa(port))
 	ms.httpServer = &http.Server{ // nolint:exhaustruct
 		Addr:              addr,
 		ReadHeaderTimeout: 5 * time.Second,
-		Handler:           promhttp.Handler(),
+		Handler:           handler,
 	}
 
 	c := make(chan error)
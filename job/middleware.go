@@ -0,0 +1,63 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+)
+
+// ExecuteFunc executes a job instance with the arguments, and returns the results of the executor.
+type ExecuteFunc = func(ctx context.Context, job Instance, args []any) ([]any, error)
+
+// Middleware wraps an ExecuteFunc to add reusable behavior around executors, such as timing, argument redaction, or concurrency limits.
+// A middleware may change the context and the arguments passed to the next function, or the results and error returned from it.
+type Middleware = func(next ExecuteFunc) ExecuteFunc
+
+// WithMiddleware adds middlewares composed around the executor of the job handler.
+// The first middleware is the outermost one, and the middlewares added later run closer to the executor.
+func WithMiddleware(mw ...Middleware) HandlerOption {
+	return func(h *handler) {
+		h.middlewares = append(h.middlewares, mw...)
+	}
+}
+
+// WithManagerMiddleware adds middlewares composed around the executors of job instances of all kinds processed by the manager.
+// The manager middlewares run outside the middlewares set to each job by WithMiddleware.
+func WithManagerMiddleware(mw ...Middleware) ManagerOption {
+	return func(m *manager) {
+		m.hooks.middlewares = append(m.hooks.middlewares, mw...)
+	}
+}
+
+// chainMiddlewares returns the ExecuteFunc composed of the middlewares around the specified function.
+func chainMiddlewares(fn ExecuteFunc, mw ...Middleware) ExecuteFunc {
+	for n := len(mw) - 1; 0 <= n; n-- {
+		if mw[n] == nil {
+			continue
+		}
+		fn = mw[n](fn)
+	}
+	return fn
+}
+
+// instanceFromOptions returns the job instance included in the executor options, or nil if not included.
+func instanceFromOptions(opts []any) Instance {
+	for _, opt := range opts {
+		if ji, ok := opt.(Instance); ok {
+			return ji
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	logger "github.com/cybergarage/go-logger/log"
+)
+
+const (
+	// DefaultNotificationMaxAttempts is the default maximum number of delivery attempts of a notification.
+	DefaultNotificationMaxAttempts = 5
+	// DefaultNotificationTimeout is the default timeout of a delivery attempt of a notification.
+	DefaultNotificationTimeout = 10 * time.Second
+	// DefaultNotificationQueueSize is the default number of notifications which can be queued for delivery.
+	DefaultNotificationQueueSize = 1024
+)
+
+const (
+	// NotificationSignatureHeader is the HTTP header of the HMAC-SHA256 signature of the notification payload, such as "sha256=<hex>".
+	NotificationSignatureHeader = "X-Go-Job-Signature"
+	// NotificationDeliveryHeader is the HTTP header of the unique delivery identifier of the notification, which is kept across retries.
+	NotificationDeliveryHeader = "X-Go-Job-Delivery"
+	// NotificationSubscriptionHeader is the HTTP header of the subscription identifier of the notification.
+	NotificationSubscriptionHeader = "X-Go-Job-Subscription"
+)
+
+const (
+	subscriptionKey        = "subscription"
+	signaturePrefix        = "sha256="
+	notifierWorkers        = 4
+	subscriptionsCacheTime = 5 * time.Second
+)
+
+// NotificationBackoffStrategy returns the delay before the next delivery attempt after the specified number of failed attempts.
+type NotificationBackoffStrategy func(attempts int) time.Duration
+
+// NewNotificationSignature returns the HMAC-SHA256 signature of the notification payload with the subscription secret, which is set to the NotificationSignatureHeader header.
+func NewNotificationSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyNotificationSignature returns true if the signature of the NotificationSignatureHeader header matches the notification payload signed with the subscription secret.
+func VerifyNotificationSignature(secret string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(NewNotificationSignature(secret, payload)), []byte(signature))
+}
+
+// defaultNotificationBackoff doubles the delay from one second for each failed attempt up to one minute.
+func defaultNotificationBackoff(attempts int) time.Duration {
+	delay := time.Second << max(attempts-1, 0)
+	if delay <= 0 || time.Minute < delay {
+		return time.Minute
+	}
+	return delay
+}
+
+// notification represents a notification of a state change to a subscription.
+type notification struct {
+	id           UUID
+	subscription Subscription
+	payload      []byte
+	attempts     int
+}
+
+// notifier delivers notifications of state changes of job instances to the matched subscriptions with retries.
+type notifier struct {
+	sync.Mutex
+
+	store         SubscriptionStore
+	client        *http.Client
+	maxAttempts   int
+	backoff       NotificationBackoffStrategy
+	queue         chan *notification
+	subs          []Subscription
+	subsUpdatedAt time.Time
+	done          chan struct{}
+	wg            sync.WaitGroup
+}
+
+func newNotifier() *notifier {
+	return &notifier{
+		Mutex:         sync.Mutex{},
+		store:         nil,
+		client:        &http.Client{Timeout: DefaultNotificationTimeout}, // nolint: exhaustruct
+		maxAttempts:   DefaultNotificationMaxAttempts,
+		backoff:       defaultNotificationBackoff,
+		queue:         make(chan *notification, DefaultNotificationQueueSize),
+		subs:          nil,
+		subsUpdatedAt: time.Time{},
+		done:          nil,
+		wg:            sync.WaitGroup{},
+	}
+}
+
+// subscriptions returns the subscriptions cached for a while to avoid looking up the store for each state change.
+func (n *notifier) subscriptions() []Subscription {
+	n.Lock()
+	defer n.Unlock()
+	if time.Since(n.subsUpdatedAt) < subscriptionsCacheTime {
+		return n.subs
+	}
+	subs, err := n.store.ListSubscriptions(context.Background())
+	if err != nil {
+		logger.Warnf("failed to list subscriptions: %s", err)
+		return n.subs
+	}
+	n.subs = subs
+	n.subsUpdatedAt = time.Now()
+	return n.subs
+}
+
+// invalidate discards the cached subscriptions.
+func (n *notifier) invalidate() {
+	n.Lock()
+	defer n.Unlock()
+	n.subsUpdatedAt = time.Time{}
+}
+
+// notify queues the notifications of the state change to the matched subscriptions.
+func (n *notifier) notify(state InstanceState) {
+	for _, sub := range n.subscriptions() {
+		if !sub.Matches(state) {
+			continue
+		}
+		m := state.Map()
+		m[subscriptionKey] = sub.ID().String()
+		payload, err := json.Marshal(m)
+		if err != nil {
+			logger.Warnf("failed to encode notification (%s): %s", sub, err)
+			continue
+		}
+		n.enqueue(&notification{
+			id:           NewUUID(),
+			subscription: sub,
+			payload:      payload,
+			attempts:     0,
+		})
+	}
+}
+
+// enqueue queues the notification for delivery. The notification is dropped if the queue is full.
+func (n *notifier) enqueue(notification *notification) {
+	select {
+	case n.queue <- notification:
+	default:
+		logger.Warnf("notification queue is full, dropped notification %s to %s", notification.id, notification.subscription)
+	}
+}
+
+// deliver posts the notification to the subscription URL, and schedules a retry if the delivery fails.
+// The retry is discarded if the notifier is stopped before the retry.
+func (n *notifier) deliver(notification *notification, done chan struct{}) {
+	notification.attempts++
+	err := n.post(notification)
+	if err == nil {
+		return
+	}
+	if n.maxAttempts <= notification.attempts {
+		logger.Errorf("notification %s to %s failed after %d attempts: %s", notification.id, notification.subscription, notification.attempts, err)
+		return
+	}
+	logger.Warnf("notification %s to %s failed (attempt %d): %s", notification.id, notification.subscription, notification.attempts, err)
+	time.AfterFunc(n.backoff(notification.attempts), func() {
+		select {
+		case <-done:
+		default:
+			n.enqueue(notification)
+		}
+	})
+}
+
+func (n *notifier) post(notification *notification) error {
+	sub := notification.subscription
+	req, err := http.NewRequest(http.MethodPost, sub.URL(), bytes.NewReader(notification.payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(NotificationDeliveryHeader, notification.id.String())
+	req.Header.Set(NotificationSubscriptionHeader, sub.ID().String())
+	if secret := sub.Secret(); 0 < len(secret) {
+		req.Header.Set(NotificationSignatureHeader, NewNotificationSignature(secret, notification.payload))
+	}
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || 299 < res.StatusCode {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+// Start starts the delivery workers of the notifier.
+func (n *notifier) Start() error {
+	n.Lock()
+	defer n.Unlock()
+	if n.done != nil {
+		return nil
+	}
+	n.done = make(chan struct{})
+	for range notifierWorkers {
+		n.wg.Add(1)
+		go func(done chan struct{}) {
+			defer n.wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				case notification := <-n.queue:
+					n.deliver(notification, done)
+				}
+			}
+		}(n.done)
+	}
+	return nil
+}
+
+// Stop stops the delivery workers of the notifier. The queued notifications are kept and delivered after the notifier is started again.
+func (n *notifier) Stop() error {
+	n.Lock()
+	if n.done == nil {
+		n.Unlock()
+		return nil
+	}
+	close(n.done)
+	n.done = nil
+	n.Unlock()
+	n.wg.Wait()
+	return nil
+}
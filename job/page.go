@@ -0,0 +1,276 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SortOrder represents the order in which lookup results are sorted by their timestamps.
+type SortOrder int
+
+const (
+	// SortAscending sorts lookup results from the oldest to the newest. This is the default order.
+	SortAscending SortOrder = iota
+	// SortDescending sorts lookup results from the newest to the oldest.
+	SortDescending
+)
+
+const (
+	sortAscendingString  = "asc"
+	sortDescendingString = "desc"
+)
+
+// NewSortOrderFromString returns the sort order of the specified string, "asc" or "desc".
+func NewSortOrderFromString(s string) (SortOrder, error) {
+	switch strings.ToLower(s) {
+	case sortAscendingString, "ascending":
+		return SortAscending, nil
+	case sortDescendingString, "descending":
+		return SortDescending, nil
+	}
+	return SortAscending, fmt.Errorf("sort order (%s) is %w", s, ErrInvalid)
+}
+
+// String returns the string representation of the sort order.
+func (order SortOrder) String() string {
+	if order == SortDescending {
+		return sortDescendingString
+	}
+	return sortAscendingString
+}
+
+// pageKey is the position of a lookup result, which is sorted by the timestamp and then by the UUID.
+type pageKey struct {
+	ts   time.Time
+	uuid uuid.UUID
+}
+
+// newPageKeyFrom returns the position of the specified lookup result. Job instances are positioned by their creation times,
+// and audit records, which have no job instance UUID, are positioned by their timestamps and their identifiers.
+func newPageKeyFrom(v any) (pageKey, bool) {
+	switch v := v.(type) {
+	case InstanceState:
+		return pageKey{ts: v.Timestamp(), uuid: v.UUID()}, true
+	case Log:
+		return pageKey{ts: v.Timestamp(), uuid: v.UUID()}, true
+	case Instance:
+		return pageKey{ts: v.CreatedAt(), uuid: v.UUID()}, true
+	case AuditRecord:
+		return pageKey{ts: v.Timestamp(), uuid: v.ID()}, true
+	}
+	return pageKey{}, false // nolint:exhaustruct
+}
+
+// compare returns -1, 0 or +1 depending on whether the key is before, equal to or after the other key.
+func (key pageKey) compare(other pageKey) int {
+	if c := key.ts.Compare(other.ts); c != 0 {
+		return c
+	}
+	return bytes.Compare(key.uuid[:], other.uuid[:])
+}
+
+// PageToken represents the position of the last result of a page, after which the next page of a lookup starts.
+// Unlike an offset, a page token keeps pointing at the same position while new results are added.
+type PageToken struct {
+	key pageKey
+}
+
+// NewPageTokenFrom returns the page token which points at the specified lookup result, such as an instance, an instance state, a log or an audit record.
+func NewPageTokenFrom(v any) (PageToken, error) {
+	key, ok := newPageKeyFrom(v)
+	if !ok {
+		return PageToken{}, fmt.Errorf("page token of %T is %w", v, ErrInvalid) // nolint:exhaustruct
+	}
+	// The timestamp is normalized as parsed from the string representation, so that the page tokens are comparable.
+	key.ts = time.Unix(0, key.ts.UnixNano())
+	return PageToken{key: key}, nil
+}
+
+// NewPageTokenFromString returns the page token of the specified string representation.
+func NewPageTokenFromString(s string) (PageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PageToken{}, fmt.Errorf("page token (%s) is %w: %w", s, ErrInvalid, err) // nolint:exhaustruct
+	}
+	nanos, id, ok := strings.Cut(string(b), "/")
+	if !ok {
+		return PageToken{}, fmt.Errorf("page token (%s) is %w", s, ErrInvalid) // nolint:exhaustruct
+	}
+	ns, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return PageToken{}, fmt.Errorf("page token (%s) is %w: %w", s, ErrInvalid, err) // nolint:exhaustruct
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		return PageToken{}, fmt.Errorf("page token (%s) is %w: %w", s, ErrInvalid, err) // nolint:exhaustruct
+	}
+	return PageToken{key: pageKey{ts: time.Unix(0, ns), uuid: uuid}}, nil
+}
+
+// IsZero returns true if the page token points at no position.
+func (token PageToken) IsZero() bool {
+	return token.key.ts.IsZero() && token.key.uuid == uuid.Nil
+}
+
+// String returns the opaque string representation of the page token.
+func (token PageToken) String() string {
+	if token.IsZero() {
+		return ""
+	}
+	s := strconv.FormatInt(token.key.ts.UnixNano(), 10) + "/" + token.key.uuid.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// NextPageToken returns the page token of the page following the specified results of the query,
+// or false if the query has no limit or the results are the last page.
+func NextPageToken[T any](query Query, results []T) (PageToken, bool) {
+	limit, ok := query.Limit()
+	if !ok || len(results) < limit || len(results) == 0 {
+		return PageToken{}, false // nolint:exhaustruct
+	}
+	token, err := NewPageTokenFrom(results[len(results)-1])
+	if err != nil {
+		return PageToken{}, false // nolint:exhaustruct
+	}
+	return token, true
+}
+
+type pageItem[T any] struct {
+	key   pageKey
+	value T
+}
+
+// Pager collects the page of lookup results which match a query. The results are sorted in the order of the query,
+// and the results up to the page token, the offset and the results beyond the limit are dropped.
+// The pager keeps at most offset+limit results, so that stores can stream their records without reading all of them into memory.
+type Pager[T any] struct {
+	query  Query
+	token  PageToken
+	size   int
+	items  []pageItem[T]
+	sorted bool
+}
+
+// NewPager returns a new pager of the specified query.
+func NewPager[T any](query Query) *Pager[T] {
+	p := &Pager[T]{
+		query:  query,
+		token:  PageToken{}, // nolint:exhaustruct
+		size:   0,
+		items:  []pageItem[T]{},
+		sorted: true,
+	}
+	if token, ok := query.PageToken(); ok {
+		p.token = token
+	}
+	if limit, ok := query.Limit(); ok {
+		p.size = query.Offset() + limit
+	}
+	return p
+}
+
+// before returns true if the key a comes before the key b in the order of the query.
+func (p *Pager[T]) before(a, b pageKey) bool {
+	if p.query.Order() == SortDescending {
+		return b.compare(a) < 0
+	}
+	return a.compare(b) < 0
+}
+
+// Add adds the specified result if it matches the query and comes after the page token.
+func (p *Pager[T]) Add(v T) {
+	if !p.query.Matches(v) {
+		return
+	}
+	key, _ := newPageKeyFrom(v)
+	if !p.token.IsZero() && !p.before(p.token.key, key) {
+		return
+	}
+	item := pageItem[T]{key: key, value: v}
+	if p.size <= 0 || len(p.items) < p.size {
+		p.items = append(p.items, item)
+		p.sorted = false
+		return
+	}
+	// The page is full, so the result replaces the last result of the page if it comes before.
+	p.sort()
+	last := len(p.items) - 1
+	if !p.before(key, p.items[last].key) {
+		return
+	}
+	n := sort.Search(len(p.items), func(i int) bool {
+		return p.before(key, p.items[i].key)
+	})
+	copy(p.items[n+1:], p.items[n:last])
+	p.items[n] = item
+}
+
+// IsFull returns true if the pager has the results up to the offset and the limit of the query.
+// Results which are added in the order of the query after the pager is full never come in the page, so callers can stop adding results.
+func (p *Pager[T]) IsFull() bool {
+	return 0 < p.size && p.size <= len(p.items)
+}
+
+func (p *Pager[T]) sort() {
+	if p.sorted {
+		return
+	}
+	sort.SliceStable(p.items, func(i, j int) bool {
+		return p.before(p.items[i].key, p.items[j].key)
+	})
+	p.sorted = true
+}
+
+// Page returns the page of the added results.
+func (p *Pager[T]) Page() []T {
+	p.sort()
+	items := p.items
+	if offset := p.query.Offset(); 0 < offset {
+		if len(items) <= offset {
+			items = nil
+		} else {
+			items = items[offset:]
+		}
+	}
+	if limit, ok := p.query.Limit(); ok && limit < len(items) {
+		items = items[:limit]
+	}
+	page := make([]T, len(items))
+	for n, item := range items {
+		page[n] = item.value
+	}
+	return page
+}
+
+// sortByPageKey sorts the specified lookup results in the specified order.
+func sortByPageKey[T any](results []T, order SortOrder) {
+	sort.SliceStable(results, func(i, j int) bool {
+		a, _ := newPageKeyFrom(results[i])
+		b, _ := newPageKeyFrom(results[j])
+		if order == SortDescending {
+			return b.compare(a) < 0
+		}
+		return a.compare(b) < 0
+	})
+}
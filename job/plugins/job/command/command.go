@@ -0,0 +1,173 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins"
+)
+
+// waitDelay is the delay to wait for the output of the killed command to be closed after the context is done.
+const waitDelay = time.Second
+
+// CommandOption is a function that configures a command job.
+type CommandOption func(*commandConfig)
+
+type commandConfig struct {
+	dir string
+	env []string
+}
+
+// WithDir sets the working directory of the command. If the directory is not set, the command runs in the current directory of the process.
+func WithDir(dir string) CommandOption {
+	return func(config *commandConfig) {
+		config.dir = dir
+	}
+}
+
+// WithEnv appends the environment variables in the form "key=value" to the environment of the process for the command.
+func WithEnv(env ...string) CommandOption {
+	return func(config *commandConfig) {
+		config.env = append(config.env, env...)
+	}
+}
+
+// templateData represents the data passed to the command line templates.
+type templateData struct {
+	Kind string
+	UUID string
+	Args []string
+}
+
+// NewCommandJob returns a job that runs the specified command line as an external process.
+// Each element of the command line is a text/template which is fed the job instance as follows:
+//   - {{.Kind}}: string - The kind of the job.
+//   - {{.UUID}}: string - The UUID of the job instance.
+//   - {{.Args}}: []string - The arguments of the job instance formatted as strings, such as {{index .Args 0}}.
+//
+// If no element of the command line refers to the template data, the arguments of the job instance are appended to the command line as they are.
+// The job results are the standard output of the command without the trailing newlines, and the exit code of the command.
+// The standard output and error of the command are also recorded line by line as the info and error logs of the job instance.
+// The job instance is terminated with an error if the command exits with a non-zero code, and the command is killed with its child processes
+// if the job instance is canceled or timed out.
+// The options accept CommandOption for the process, and the job options such as job.WithDescription and job.WithTimeout.
+func NewCommandJob(kind string, command []string, opts ...any) (plugins.Job, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("command of job %q is %w", kind, job.ErrInvalid)
+	}
+
+	config := &commandConfig{
+		dir: "",
+		env: nil,
+	}
+	jobOpts := []any{}
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case CommandOption:
+			opt(config)
+		default:
+			jobOpts = append(jobOpts, opt)
+		}
+	}
+
+	templates := make([]*template.Template, len(command))
+	hasTemplate := false
+	for n, elem := range command {
+		tmpl, err := template.New(kind).Option("missingkey=error").Parse(elem)
+		if err != nil {
+			return nil, fmt.Errorf("command %q of job %q is %w (%w)", elem, kind, job.ErrInvalid, err)
+		}
+		templates[n] = tmpl
+		if strings.Contains(elem, "{{") {
+			hasTemplate = true
+		}
+	}
+
+	commandLine := func(ji job.Instance) ([]string, error) {
+		data := templateData{
+			Kind: ji.Kind(),
+			UUID: ji.UUID().String(),
+			Args: make([]string, len(ji.Arguments())),
+		}
+		for n, arg := range ji.Arguments() {
+			data.Args[n] = fmt.Sprintf("%v", arg)
+		}
+		line := make([]string, len(templates))
+		for n, tmpl := range templates {
+			var b strings.Builder
+			if err := tmpl.Execute(&b, data); err != nil {
+				return nil, err
+			}
+			line[n] = b.String()
+		}
+		if !hasTemplate {
+			line = append(line, data.Args...)
+		}
+		return line, nil
+	}
+
+	executor := func(ctx context.Context, ji job.Instance) (string, int, error) {
+		line, err := commandLine(ji)
+		if err != nil {
+			return "", -1, err
+		}
+		cmd := exec.CommandContext(ctx, line[0], line[1:]...) // nolint: gosec
+		cmd.Dir = config.dir
+		if 0 < len(config.env) {
+			cmd.Env = append(os.Environ(), config.env...)
+		}
+		setProcessGroup(cmd)
+		cmd.WaitDelay = waitDelay
+		var stdout bytes.Buffer
+		stdoutLogger := newLineWriter(func(line string) { ji.Infof("%s", line) })
+		stderrLogger := newLineWriter(func(line string) { ji.Errorf("%s", line) })
+		cmd.Stdout = io.MultiWriter(&stdout, stdoutLogger)
+		cmd.Stderr = stderrLogger
+		err = cmd.Run()
+		stdoutLogger.Flush()
+		stderrLogger.Flush()
+		output := strings.TrimRight(stdout.String(), "\r\n")
+		if err != nil {
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) {
+				return output, -1, err
+			}
+			errMsg := stderrLogger.LastLine()
+			if 0 < len(errMsg) {
+				return output, exitErr.ExitCode(), fmt.Errorf("%s: %w (%s)", line[0], err, errMsg)
+			}
+			return output, exitErr.ExitCode(), fmt.Errorf("%s: %w", line[0], err)
+		}
+		return output, 0, nil
+	}
+
+	jobOpts = append(jobOpts,
+		job.WithKind(kind),
+		job.WithExecutor(executor),
+		job.WithFailOnErrorResult(),
+	)
+	return job.NewJob(jobOpts...)
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"strings"
+)
+
+// lineWriter is a writer which passes the written output line by line to the log function, skipping the blank lines.
+type lineWriter struct {
+	log      func(line string)
+	buf      bytes.Buffer
+	lastLine string
+}
+
+func newLineWriter(log func(line string)) *lineWriter {
+	return &lineWriter{
+		log:      log,
+		buf:      bytes.Buffer{},
+		lastLine: "",
+	}
+}
+
+// Write writes the output, and logs the completed lines.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadString('\n')
+		if err != nil {
+			// Keep the incomplete line until the rest is written.
+			w.buf.Reset()
+			w.buf.WriteString(line)
+			break
+		}
+		w.writeLine(line)
+	}
+	return len(p), nil
+}
+
+// Flush logs the remaining incomplete line.
+func (w *lineWriter) Flush() {
+	if 0 < w.buf.Len() {
+		w.writeLine(w.buf.String())
+		w.buf.Reset()
+	}
+}
+
+// LastLine returns the last line written.
+func (w *lineWriter) LastLine() string {
+	return w.lastLine
+}
+
+func (w *lineWriter) writeLine(line string) {
+	line = strings.TrimRight(line, "\r\n")
+	if len(strings.TrimSpace(line)) == 0 {
+		return
+	}
+	w.lastLine = line
+	w.log(line)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins"
+)
+
+const (
+	AuditCleaner = "system.audit.cleaner"
+)
+
+// NewAuditCleaner returns a job that cleans up old audit records.
+// The job executor accepts the following parameters:
+//   - ctx: context.Context - The context for cancellation and timeout.
+//   - mgr: job.Manager - The job manager to perform the cleanup operation.
+//   - ji: job.Instance - The job instance representing the audit cleaner job.
+//   - before: time.Time - A timestamp indicating that all audit records before this time should be deleted.
+//
+// If the retention period is set by WithRetention, the before parameter is omitted and computed from the retention period at each execution.
+func NewAuditCleaner(opts ...CleanerOption) plugins.Job {
+	config := newCleanerConfig(opts...)
+	job, _ := job.NewJob(
+		job.WithKind(AuditCleaner),
+		job.WithJitter(func() time.Duration {
+			r := rand.New(rand.NewSource(time.Now().UnixNano() + int64(rand.Intn(1000000)))) // nolint: gosec
+			return time.Duration(r.Intn(10)) * time.Second
+		}),
+		job.WithExecutor(newCleanerExecutor(config,
+			func(ctx context.Context, mgr job.Manager, ji job.Instance, before time.Time) {
+				filter := job.NewFilter(
+					job.WithFilterBefore(before),
+				)
+				err := mgr.ClearAuditRecords(filter)
+				if err != nil {
+					ji.Errorf("Failed to clear audit records: %v", err)
+				}
+			})),
+	)
+	return job
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+// CleanerOption is a function that configures a system cleaner job.
+type CleanerOption func(*cleanerConfig)
+
+type cleanerConfig struct {
+	retention time.Duration
+}
+
+// WithRetention sets the retention period of the cleaner job.
+// If the retention period is set, the cleaner deletes the records older than the retention period at each execution instead of taking the before argument,
+// so that recurring cleaners keep the records of the period.
+func WithRetention(retention time.Duration) CleanerOption {
+	return func(config *cleanerConfig) {
+		config.retention = retention
+	}
+}
+
+func newCleanerConfig(opts ...CleanerOption) *cleanerConfig {
+	config := &cleanerConfig{
+		retention: 0,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// newCleanerExecutor returns the executor of the cleaner job which clears the records before the specified time.
+func newCleanerExecutor(config *cleanerConfig, clean func(ctx context.Context, mgr job.Manager, ji job.Instance, before time.Time)) job.Executor {
+	if 0 < config.retention {
+		return func(ctx context.Context, mgr job.Manager, ji job.Instance) {
+			clean(ctx, mgr, ji, time.Now().Add(-config.retention))
+		}
+	}
+	return clean
+}
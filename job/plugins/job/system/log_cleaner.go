@@ -33,14 +33,17 @@ const (
 //   - mgr: job.Manager - The job manager to perform the cleanup operation.
 //   - ji: job.Instance - The job instance representing the log cleaner job.
 //   - before: time.Time - A timestamp indicating that all job instances completed before this time should be deleted.
-func NewLogCleaner() plugins.Job {
+//
+// If the retention period is set by WithRetention, the before parameter is omitted and computed from the retention period at each execution.
+func NewLogCleaner(opts ...CleanerOption) plugins.Job {
+	config := newCleanerConfig(opts...)
 	job, _ := job.NewJob(
 		job.WithKind(LogCleaner),
 		job.WithJitter(func() time.Duration {
 			r := rand.New(rand.NewSource(time.Now().UnixNano() + int64(rand.Intn(1000000)))) // nolint: gosec
 			return time.Duration(r.Intn(10)) * time.Second
 		}),
-		job.WithExecutor(
+		job.WithExecutor(newCleanerExecutor(config,
 			func(ctx context.Context, mgr job.Manager, ji job.Instance, before time.Time) {
 				filter := job.NewFilter(
 					job.WithFilterBefore(before),
@@ -49,7 +52,7 @@ func NewLogCleaner() plugins.Job {
 				if err != nil {
 					ji.Errorf("Failed to clear job logs: %v", err)
 				}
-			}),
+			})),
 	)
 	return job
 }
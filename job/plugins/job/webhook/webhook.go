@@ -0,0 +1,145 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins"
+)
+
+// DefaultMaxResponseSize is the default maximum size of the response body stored in the result set.
+const DefaultMaxResponseSize = 1024 * 1024
+
+// ErrUnexpectedStatus is returned when the HTTP response status is not 2xx.
+var ErrUnexpectedStatus = errors.New("unexpected status")
+
+// Request represents the HTTP request of a webhook job instance, which is given as the argument of the instance.
+// The argument is a map or a JSON object string with the following keys, or the named arguments of the keys:
+//   - method: string - The HTTP method. If the method is empty, GET is used without the body and POST is used with the body.
+//   - url: string - The request URL.
+//   - header: map[string]any - The request headers in addition to the headers set by WithHeader.
+//   - body: string - The request body.
+type Request struct {
+	Method string         `job:"method"`
+	URL    string         `job:"url"`
+	Header map[string]any `job:"header"`
+	Body   string         `job:"body"`
+}
+
+// WebhookOption is a function that configures a webhook job.
+type WebhookOption func(*webhookConfig)
+
+type webhookConfig struct {
+	client          *http.Client
+	header          http.Header
+	maxResponseSize int64
+}
+
+// WithClient sets the HTTP client used to perform the requests. If the client is not set, http.DefaultClient is used.
+func WithClient(client *http.Client) WebhookOption {
+	return func(config *webhookConfig) {
+		config.client = client
+	}
+}
+
+// WithHeader adds the header to all requests of the job, such as an authorization header of the webhook.
+func WithHeader(key string, value string) WebhookOption {
+	return func(config *webhookConfig) {
+		config.header.Add(key, value)
+	}
+}
+
+// WithMaxResponseSize sets the maximum size of the response body stored in the result set. The exceeded part of the body is discarded.
+func WithMaxResponseSize(size int64) WebhookOption {
+	return func(config *webhookConfig) {
+		config.maxResponseSize = size
+	}
+}
+
+// NewWebhookJob returns a job that performs the HTTP request given as the argument of the job instance.
+// The job results are the status code and the body of the response.
+// The job instance is terminated with an error which wraps ErrUnexpectedStatus if the status is not 2xx,
+// so that the job instance is retried by the retry policy, and the request is aborted if the job instance is canceled or timed out.
+// The options accept WebhookOption for the requests, and the job options such as job.WithDescription, job.WithTimeout and job.WithMaxRetries.
+func NewWebhookJob(kind string, opts ...any) (plugins.Job, error) {
+	config := &webhookConfig{
+		client:          http.DefaultClient,
+		header:          http.Header{},
+		maxResponseSize: DefaultMaxResponseSize,
+	}
+	jobOpts := []any{}
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case WebhookOption:
+			opt(config)
+		default:
+			jobOpts = append(jobOpts, opt)
+		}
+	}
+
+	executor := func(ctx context.Context, ji job.Instance, req *Request) (int, string, error) {
+		if len(req.URL) == 0 {
+			return 0, "", fmt.Errorf("url is %w", job.ErrInvalid)
+		}
+		method := strings.ToUpper(req.Method)
+		if len(method) == 0 {
+			method = http.MethodGet
+			if 0 < len(req.Body) {
+				method = http.MethodPost
+			}
+		}
+		var body io.Reader
+		if 0 < len(req.Body) {
+			body = strings.NewReader(req.Body)
+		}
+		httpReq, err := http.NewRequestWithContext(ctx, method, req.URL, body)
+		if err != nil {
+			return 0, "", err
+		}
+		httpReq.Header = config.header.Clone()
+		for key, value := range req.Header {
+			httpReq.Header.Add(key, fmt.Sprintf("%v", value))
+		}
+
+		res, err := config.client.Do(httpReq)
+		if err != nil {
+			return 0, "", err
+		}
+		defer res.Body.Close()
+		resBody, err := io.ReadAll(io.LimitReader(res.Body, config.maxResponseSize))
+		if err != nil {
+			return res.StatusCode, "", err
+		}
+		ji.Infof("%s %s: %s", method, req.URL, res.Status)
+		if res.StatusCode < 200 || 299 < res.StatusCode {
+			return res.StatusCode, string(resBody), fmt.Errorf("%w %s from %s %s", ErrUnexpectedStatus, res.Status, method, req.URL)
+		}
+		return res.StatusCode, string(resBody), nil
+	}
+
+	jobOpts = append(jobOpts,
+		job.WithKind(kind),
+		job.WithExecutor(executor),
+		job.WithFailOnErrorResult(),
+	)
+	return job.NewJob(jobOpts...)
+}
@@ -20,3 +20,6 @@ import (
 
 // Store represents a job store plugin interface.
 type Store = job.Store
+
+// BlobStore represents a blob store plugin interface.
+type BlobStore = job.BlobStore
@@ -0,0 +1,120 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+// FileStore represents a blob store which stores blobs as files in a local directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a new blob store which stores blobs under the specified directory.
+// Blobs are stored as files named by the blob name in a subdirectory named by the job instance UUID.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{
+		dir: dir,
+	}
+}
+
+// Name returns the name of this blob store.
+func (store *FileStore) Name() string {
+	return "file"
+}
+
+// Dir returns the root directory of this blob store.
+func (store *FileStore) Dir() string {
+	return store.dir
+}
+
+func (store *FileStore) instanceDir(uuid job.UUID) string {
+	return filepath.Join(store.dir, uuid.String())
+}
+
+func (store *FileStore) blobPath(uuid job.UUID, name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid blob name: %q", name)
+	}
+	return filepath.Join(store.instanceDir(uuid), name), nil
+}
+
+// PutBlob stores a blob with the specified name for a job instance.
+func (store *FileStore) PutBlob(ctx context.Context, uuid job.UUID, name string, data []byte) error {
+	path, err := store.blobPath(uuid, name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it so that readers never see a partially written blob.
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+name+"-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// GetBlob returns the blob with the specified name for a job instance.
+func (store *FileStore) GetBlob(ctx context.Context, uuid job.UUID, name string) ([]byte, error) {
+	path, err := store.blobPath(uuid, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("blob (%s/%s) %w", uuid, name, job.ErrNotFound)
+	}
+	return data, err
+}
+
+// DeleteBlobs deletes all blobs for a job instance.
+func (store *FileStore) DeleteBlobs(ctx context.Context, uuid job.UUID) error {
+	return os.RemoveAll(store.instanceDir(uuid))
+}
+
+// ClearBlobs deletes all blobs in the blob store.
+func (store *FileStore) ClearBlobs(ctx context.Context) error {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(store.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"github.com/cybergarage/go-job/job"
+)
+
+// NewAuditRecordKeyFrom creates a new key for an audit record.
+func NewAuditRecordKeyFrom(suffixes ...string) Key {
+	return newKeyFrom(auditPrefix, suffixes...)
+}
+
+// NewAuditRecordListKey creates a new list key for audit records.
+func NewAuditRecordListKey() Key {
+	return Key(auditPrefix)
+}
+
+// NewObjectFromAuditRecord creates a new object from an audit record.
+func NewObjectFromAuditRecord(record job.AuditRecord, keySuffixes ...string) (Object, error) {
+	return NewObjectFromAuditRecordWith(NewConfig(), record, keySuffixes...)
+}
+
+// NewObjectFromAuditRecordWith creates a new object from an audit record using the specified configuration.
+func NewObjectFromAuditRecordWith(config Config, record job.AuditRecord, keySuffixes ...string) (Object, error) {
+	data, err := EncodeObjectValue(config, "audit record ("+string(record.Operation())+")", record.Map())
+	if err != nil {
+		return nil, err
+	}
+	return &object{
+		key:   NewAuditRecordKeyFrom(keySuffixes...),
+		value: data,
+	}, nil
+}
+
+// NewAuditRecordFromBytes creates an audit record from a byte slice.
+func NewAuditRecordFromBytes(b []byte) (job.AuditRecord, error) {
+	m, err := DecodeMap(b)
+	if err != nil {
+		return nil, err
+	}
+	return job.NewAuditRecordFromMap(m)
+}
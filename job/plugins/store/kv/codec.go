@@ -0,0 +1,115 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+)
+
+// Format represents a serialization format of key-value object values.
+type Format byte
+
+const (
+	// FormatJSON represents the JSON format. JSON values are stored without a format tag to keep existing data readable.
+	FormatJSON Format = 'j'
+	// FormatMessagePack represents the MessagePack format.
+	FormatMessagePack Format = 'm'
+	// FormatProtobuf represents the protobuf format using the private persistence Object message.
+	FormatProtobuf Format = 'p'
+)
+
+// formatTagMarker is the first byte of tagged values. JSON objects never start with this byte.
+const formatTagMarker byte = 0x00
+
+// Codec represents a codec which encodes and decodes key-value object values.
+type Codec interface {
+	// Format returns the serialization format of the codec.
+	Format() Format
+	// Encode encodes the specified map into bytes.
+	Encode(m map[string]any) ([]byte, error)
+	// Decode decodes the specified bytes into a map.
+	Decode(b []byte) (map[string]any, error)
+}
+
+// NewCodecFrom returns a built-in codec for the specified format.
+func NewCodecFrom(format Format) (Codec, error) {
+	switch format {
+	case FormatJSON:
+		return NewJSONCodec(), nil
+	case FormatMessagePack:
+		return NewMessagePackCodec(), nil
+	case FormatProtobuf:
+		return NewProtobufCodec(), nil
+	}
+	return nil, fmt.Errorf("%w codec format: %q", ErrInvalid, format)
+}
+
+// String returns the string representation of the format.
+func (format Format) String() string {
+	switch format {
+	case FormatJSON:
+		return "json"
+	case FormatMessagePack:
+		return "msgpack"
+	case FormatProtobuf:
+		return "protobuf"
+	}
+	return fmt.Sprintf("unknown(%q)", byte(format))
+}
+
+// EncodeMap encodes the specified map with the codec, and tags the encoded bytes with the codec format except for JSON.
+func EncodeMap(codec Codec, m map[string]any) ([]byte, error) {
+	b, err := codec.Encode(m)
+	if err != nil {
+		return nil, err
+	}
+	if codec.Format() == FormatJSON {
+		return b, nil
+	}
+	return append([]byte{formatTagMarker, byte(codec.Format())}, b...), nil
+}
+
+// EncodeObjectValue encodes the specified map with the codec of the configuration, compresses the encoded bytes with the configured compression, and checks the maximum payload size.
+func EncodeObjectValue(config Config, name string, m map[string]any) ([]byte, error) {
+	b, err := EncodeMap(config.Codec(), m)
+	if err != nil {
+		return nil, err
+	}
+	b, err = Compress(config.Compression(), b)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize := config.MaxPayloadSize(); 0 < maxSize && maxSize < len(b) {
+		return nil, NewErrPayloadTooLarge(name, len(b), maxSize)
+	}
+	return b, nil
+}
+
+// DecodeMap decodes the specified bytes with the codec selected by the format tag. Untagged bytes are decoded as JSON.
+// Compressed bytes are decompressed before decoding.
+func DecodeMap(b []byte) (map[string]any, error) {
+	b, err := Decompress(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 2 || b[0] != formatTagMarker {
+		return NewJSONCodec().Decode(b)
+	}
+	codec, err := NewCodecFrom(Format(b[1]))
+	if err != nil {
+		return nil, err
+	}
+	return codec.Decode(b[2:])
+}
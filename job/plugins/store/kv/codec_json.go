@@ -0,0 +1,45 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"github.com/cybergarage/go-job/job/encoding"
+)
+
+type jsonCodec struct{}
+
+// NewJSONCodec returns a new JSON codec.
+func NewJSONCodec() Codec {
+	return &jsonCodec{}
+}
+
+// Format returns the serialization format of the codec.
+func (codec *jsonCodec) Format() Format {
+	return FormatJSON
+}
+
+// Encode encodes the specified map into JSON bytes.
+func (codec *jsonCodec) Encode(m map[string]any) ([]byte, error) {
+	s, err := encoding.MapToJSON(m)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// Decode decodes the specified JSON bytes into a map.
+func (codec *jsonCodec) Decode(b []byte) (map[string]any, error) {
+	return encoding.MapFromJSON(string(b))
+}
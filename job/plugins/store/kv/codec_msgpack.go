@@ -0,0 +1,45 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type msgpackCodec struct{}
+
+// NewMessagePackCodec returns a new MessagePack codec.
+func NewMessagePackCodec() Codec {
+	return &msgpackCodec{}
+}
+
+// Format returns the serialization format of the codec.
+func (codec *msgpackCodec) Format() Format {
+	return FormatMessagePack
+}
+
+// Encode encodes the specified map into MessagePack bytes.
+func (codec *msgpackCodec) Encode(m map[string]any) ([]byte, error) {
+	return msgpack.Marshal(m)
+}
+
+// Decode decodes the specified MessagePack bytes into a map.
+func (codec *msgpackCodec) Decode(b []byte) (map[string]any, error) {
+	var m map[string]any
+	if err := msgpack.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
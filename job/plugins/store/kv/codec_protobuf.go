@@ -0,0 +1,161 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"fmt"
+	"reflect"
+
+	pb "github.com/cybergarage/go-job/job/plugins/store/kv/internal/api/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type protobufCodec struct{}
+
+// NewProtobufCodec returns a new protobuf codec which encodes maps as private persistence Object messages.
+// The map values are stored as typed values, so integers, floats, strings, bytes, lists and maps keep their types on round-trip.
+func NewProtobufCodec() Codec {
+	return &protobufCodec{}
+}
+
+// Format returns the serialization format of the codec.
+func (codec *protobufCodec) Format() Format {
+	return FormatProtobuf
+}
+
+// Encode encodes the specified map into protobuf bytes.
+func (codec *protobufCodec) Encode(m map[string]any) ([]byte, error) {
+	obj, err := newProtoObjectFrom(m)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(obj)
+}
+
+// Decode decodes the specified protobuf bytes into a map.
+func (codec *protobufCodec) Decode(b []byte) (map[string]any, error) {
+	var obj pb.Object
+	if err := proto.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return newMapFromProtoObject(&obj), nil
+}
+
+// newProtoObjectFrom returns a new Object message of the specified map.
+func newProtoObjectFrom(m map[string]any) (*pb.Object, error) {
+	obj := &pb.Object{
+		Fields: make(map[string]*pb.Value, len(m)),
+	}
+	for key, value := range m {
+		v, err := newProtoValueFrom(reflect.ValueOf(value))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		obj.Fields[key] = v
+	}
+	return obj, nil
+}
+
+// newProtoValueFrom returns a new typed Value message of the specified value.
+func newProtoValueFrom(rv reflect.Value) (*pb.Value, error) {
+	if !rv.IsValid() {
+		return &pb.Value{}, nil // nolint:exhaustruct
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return &pb.Value{Kind: &pb.Value_BoolValue{BoolValue: rv.Bool()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &pb.Value{Kind: &pb.Value_IntValue{IntValue: rv.Int()}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &pb.Value{Kind: &pb.Value_UintValue{UintValue: rv.Uint()}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &pb.Value{Kind: &pb.Value_DoubleValue{DoubleValue: rv.Float()}}, nil
+	case reflect.String:
+		return &pb.Value{Kind: &pb.Value_StringValue{StringValue: rv.String()}}, nil
+	case reflect.Interface, reflect.Pointer:
+		if rv.IsNil() {
+			return &pb.Value{}, nil // nolint:exhaustruct
+		}
+		return newProtoValueFrom(rv.Elem())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return &pb.Value{Kind: &pb.Value_BytesValue{BytesValue: rv.Bytes()}}, nil
+		}
+		list := &pb.List{
+			Values: make([]*pb.Value, rv.Len()),
+		}
+		for n := range rv.Len() {
+			v, err := newProtoValueFrom(rv.Index(n))
+			if err != nil {
+				return nil, err
+			}
+			list.Values[n] = v
+		}
+		return &pb.Value{Kind: &pb.Value_ListValue{ListValue: list}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		obj := &pb.Object{
+			Fields: make(map[string]*pb.Value, rv.Len()),
+		}
+		iter := rv.MapRange()
+		for iter.Next() {
+			v, err := newProtoValueFrom(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			obj.Fields[iter.Key().String()] = v
+		}
+		return &pb.Value{Kind: &pb.Value_MapValue{MapValue: obj}}, nil
+	}
+	return nil, fmt.Errorf("%w protobuf value type: %s", ErrInvalid, rv.Type())
+}
+
+// newMapFromProtoObject returns a new map of the specified Object message.
+func newMapFromProtoObject(obj *pb.Object) map[string]any {
+	m := make(map[string]any, len(obj.GetFields()))
+	for key, value := range obj.GetFields() {
+		m[key] = newAnyFromProtoValue(value)
+	}
+	return m
+}
+
+// newAnyFromProtoValue returns the Go value of the specified Value message.
+func newAnyFromProtoValue(v *pb.Value) any {
+	switch kind := v.GetKind().(type) {
+	case *pb.Value_BoolValue:
+		return kind.BoolValue
+	case *pb.Value_IntValue:
+		return kind.IntValue
+	case *pb.Value_UintValue:
+		return kind.UintValue
+	case *pb.Value_DoubleValue:
+		return kind.DoubleValue
+	case *pb.Value_StringValue:
+		return kind.StringValue
+	case *pb.Value_BytesValue:
+		return kind.BytesValue
+	case *pb.Value_ListValue:
+		values := make([]any, len(kind.ListValue.GetValues()))
+		for n, value := range kind.ListValue.GetValues() {
+			values[n] = newAnyFromProtoValue(value)
+		}
+		return values
+	case *pb.Value_MapValue:
+		return newMapFromProtoObject(kind.MapValue)
+	}
+	return nil
+}
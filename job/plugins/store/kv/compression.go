@@ -0,0 +1,113 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression represents a compression algorithm of key-value object values.
+type Compression byte
+
+const (
+	// CompressionNone represents no compression.
+	CompressionNone Compression = 0
+	// CompressionGzip represents the gzip compression.
+	CompressionGzip Compression = 'g'
+	// CompressionZstd represents the zstd compression.
+	CompressionZstd Compression = 'z'
+)
+
+// compressionTagMarker is the first byte of compressed values. Neither JSON objects nor format tagged values start with this byte.
+const compressionTagMarker byte = 0x01
+
+// String returns the string representation of the compression.
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionGzip:
+		return "gzip"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return fmt.Sprintf("unknown(%q)", byte(c))
+}
+
+// Compress compresses the specified bytes, and tags the compressed bytes with the compression algorithm.
+// If the compression is CompressionNone, the specified bytes are returned as is.
+func Compress(c Compression, b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	switch c {
+	case CompressionNone:
+		return b, nil
+	case CompressionGzip:
+		buf.Write([]byte{compressionTagMarker, byte(c)})
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(b); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		buf.Write([]byte{compressionTagMarker, byte(c)})
+		w, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(b); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w compression: %s", ErrInvalid, c)
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses the specified bytes with the compression algorithm selected by the compression tag.
+// Untagged bytes are returned as is.
+func Decompress(b []byte) ([]byte, error) {
+	if len(b) < 2 || b[0] != compressionTagMarker {
+		return b, nil
+	}
+	c := Compression(b[1])
+	r := bytes.NewReader(b[2:])
+	switch c {
+	case CompressionGzip:
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return io.ReadAll(zr)
+	case CompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return io.ReadAll(zr)
+	}
+	return nil, fmt.Errorf("%w compression: %s", ErrInvalid, c)
+}
@@ -18,13 +18,22 @@ package kv
 type Config interface {
 	// UniqueKeys returns whether keys should be unique.
 	UniqueKeys() bool
+	// Codec returns the codec used to encode object values.
+	Codec() Codec
+	// Compression returns the compression algorithm used to compress object values.
+	Compression() Compression
+	// MaxPayloadSize returns the maximum size of object values in bytes. Zero means unlimited.
+	MaxPayloadSize() int
 }
 
 // ConfigOption defines a function that modifies the Config.
 type ConfigOption func(*config)
 
 type config struct {
-	uniqueKeys bool
+	uniqueKeys     bool
+	codec          Codec
+	compression    Compression
+	maxPayloadSize int
 }
 
 // WithUniqueKeys sets whether keys should be unique.
@@ -34,10 +43,34 @@ func WithUniqueKeys(unique bool) ConfigOption {
 	}
 }
 
+// WithCodec sets the codec used to encode object values.
+func WithCodec(codec Codec) ConfigOption {
+	return func(c *config) {
+		c.codec = codec
+	}
+}
+
+// WithCompression sets the compression algorithm used to compress object values.
+func WithCompression(compression Compression) ConfigOption {
+	return func(c *config) {
+		c.compression = compression
+	}
+}
+
+// WithMaxPayloadSize sets the maximum size of object values in bytes. Zero means unlimited.
+func WithMaxPayloadSize(size int) ConfigOption {
+	return func(c *config) {
+		c.maxPayloadSize = size
+	}
+}
+
 // NewConfig creates a new Config with default values.
 func NewConfig(opts ...ConfigOption) Config {
 	c := &config{
-		uniqueKeys: true, // default to unique keys
+		uniqueKeys:     true, // default to unique keys
+		codec:          NewJSONCodec(),
+		compression:    CompressionNone,
+		maxPayloadSize: 0, // default to unlimited
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -49,3 +82,18 @@ func NewConfig(opts ...ConfigOption) Config {
 func (c *config) UniqueKeys() bool {
 	return c.uniqueKeys
 }
+
+// Codec returns the codec used to encode object values.
+func (c *config) Codec() Codec {
+	return c.codec
+}
+
+// Compression returns the compression algorithm used to compress object values.
+func (c *config) Compression() Compression {
+	return c.compression
+}
+
+// MaxPayloadSize returns the maximum size of object values in bytes. Zero means unlimited.
+func (c *config) MaxPayloadSize() int {
+	return c.maxPayloadSize
+}
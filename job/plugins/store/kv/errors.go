@@ -20,8 +20,10 @@ import (
 )
 
 var (
-	ErrNotExist = errors.New("not exist")
-	ErrNotReady = errors.New("not ready")
+	ErrNotExist        = errors.New("not exist")
+	ErrNotReady        = errors.New("not ready")
+	ErrInvalid         = errors.New("invalid")
+	ErrPayloadTooLarge = errors.New("payload too large")
 )
 
 // NewErrKeyObjectNotExist returns a new error that the object is not exist.
@@ -33,3 +35,8 @@ func NewErrKeyObjectNotExist(key Key) error {
 func NewErrObjectNotExist(obj Object) error {
 	return fmt.Errorf("object (%s) is %w ", obj.String(), ErrNotExist)
 }
+
+// NewErrPayloadTooLarge returns a new error that the object value exceeds the maximum payload size.
+func NewErrPayloadTooLarge(name string, size int, maxSize int) error {
+	return fmt.Errorf("%s payload (%d bytes) exceeds the maximum size (%d bytes): %w", name, size, maxSize, ErrPayloadTooLarge)
+}
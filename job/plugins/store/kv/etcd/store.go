@@ -31,11 +31,18 @@ type Store struct {
 	opt StoreOption
 }
 
+// DefaultMaxPayloadSize is the default maximum size of object values, which is the default request size limit of etcd servers.
+const DefaultMaxPayloadSize = 1536 * 1024
+
 // NewStore returns a new etcd store instance.
-func NewStore(option StoreOption) kv.Store {
+// The codec and other store configurations can be set by the specified config options.
+func NewStore(option StoreOption, opts ...kv.ConfigOption) kv.Store {
 	return &Store{
 		Config: kv.NewConfig(
-			kv.WithUniqueKeys(true),
+			append([]kv.ConfigOption{
+				kv.WithUniqueKeys(true),
+				kv.WithMaxPayloadSize(DefaultMaxPayloadSize),
+			}, opts...)...,
 		),
 		opt:    option,
 		Client: nil,
@@ -64,6 +71,15 @@ func (store *Store) Start() error {
 	return nil
 }
 
+// Ping checks the connectivity to the etcd cluster.
+func (store *Store) Ping(ctx context.Context) error {
+	if store.Client == nil {
+		return kv.ErrNotReady
+	}
+	_, err := store.Client.Status(ctx, store.opt.Endpoints[0])
+	return err
+}
+
 // Clear removes all key-value objects from the store.
 func (store *Store) Clear() error {
 	if store.Client == nil {
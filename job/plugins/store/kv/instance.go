@@ -18,7 +18,6 @@ import (
 	"fmt"
 
 	"github.com/cybergarage/go-job/job"
-	"github.com/cybergarage/go-job/job/encoding"
 )
 
 // NewInstanceKeyFromUUID creates a new key from a UUID string.
@@ -33,19 +32,24 @@ func NewInstanceListKey() Key {
 
 // NewObjectFromInstance creates a new Object from a job instance.
 func NewObjectFromInstance(ji job.Instance, suffixes ...string) (Object, error) {
-	data, err := encoding.MapToJSON(ji.Map())
+	return NewObjectFromInstanceWith(NewConfig(), ji, suffixes...)
+}
+
+// NewObjectFromInstanceWith creates a new Object from a job instance using the specified configuration.
+func NewObjectFromInstanceWith(config Config, ji job.Instance, suffixes ...string) (Object, error) {
+	data, err := EncodeObjectValue(config, "job instance ("+ji.Kind()+")", ji.Map())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get JSON string from job instance: %w", err)
+		return nil, fmt.Errorf("failed to encode job instance (%s): %w", config.Codec().Format(), err)
 	}
 	return &object{
 		key:   NewInstanceKeyFrom(ji, suffixes...),
-		value: []byte(data),
+		value: data,
 	}, nil
 }
 
 // NewInstanceFromBytes creates a job instance from a byte slice.
 func NewInstanceFromBytes(b []byte, opts ...any) (job.Instance, error) {
-	m, err := encoding.MapFromJSON(string(b))
+	m, err := DecodeMap(b)
 	if err != nil {
 		return nil, err
 	}
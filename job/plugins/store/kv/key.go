@@ -30,6 +30,8 @@ const (
 	instancePrefix      KeyTypePrefix = "i"
 	instanceStatePrefix KeyTypePrefix = "s"
 	instanceLogPrefix   KeyTypePrefix = "l"
+	auditPrefix         KeyTypePrefix = "a"
+	subscriptionPrefix  KeyTypePrefix = "w"
 )
 
 func newKeyFrom(prefix string, suffixes ...string) Key {
@@ -16,7 +16,7 @@ package kv
 
 import (
 	"github.com/cybergarage/go-job/job"
-	"github.com/cybergarage/go-job/job/encoding"
+	"github.com/google/uuid"
 )
 
 // NewLogKeyFrom creates a new key for a job log.
@@ -29,21 +29,31 @@ func NewLogListKey() Key {
 	return Key(instanceLogPrefix)
 }
 
+// NewLogListKeyWith returns the key prefix of the log entries of the specified job instance, which are stored with unique keys.
+func NewLogListKeyWith(uuid uuid.UUID) Key {
+	return newKeyFrom(instanceLogPrefix, uuid.String())
+}
+
 // NewObjectFromLog creates a new object from a job log entry.
 func NewObjectFromLog(log job.Log, keySuffixes ...string) (Object, error) {
-	data, err := encoding.MapToJSON(log.Map())
+	return NewObjectFromLogWith(NewConfig(), log, keySuffixes...)
+}
+
+// NewObjectFromLogWith creates a new object from a job log entry using the specified configuration.
+func NewObjectFromLogWith(config Config, log job.Log, keySuffixes ...string) (Object, error) {
+	data, err := EncodeObjectValue(config, "instance log ("+log.Kind()+")", log.Map())
 	if err != nil {
 		return nil, err
 	}
 	return &object{
 		key:   NewLogKeyFrom(keySuffixes...),
-		value: []byte(data),
+		value: data,
 	}, nil
 }
 
 // NewLogFromMap creates a new log entry from a map representation.
 func NewLogFromBytes(b []byte) (job.Log, error) {
-	m, err := encoding.MapFromJSON(string(b))
+	m, err := DecodeMap(b)
 	if err != nil {
 		return nil, err
 	}
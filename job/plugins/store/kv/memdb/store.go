@@ -25,10 +25,13 @@ type Store struct {
 }
 
 // NewStore returns a new memdb store instance.
-func NewStore() kv.Store {
+// The codec and other store configurations can be set by the specified config options.
+func NewStore(opts ...kv.ConfigOption) kv.Store {
 	return &Store{
 		Config: kv.NewConfig(
-			kv.WithUniqueKeys(true), // default to unique keys
+			append([]kv.ConfigOption{
+				kv.WithUniqueKeys(true), // default to unique keys
+			}, opts...)...,
 		),
 		Database: nil,
 	}
@@ -16,8 +16,6 @@ package kv
 
 import (
 	"bytes"
-
-	"github.com/cybergarage/go-job/job/encoding"
 )
 
 // Object represents a key-value object.
@@ -70,7 +68,7 @@ func (obj *object) Bytes() []byte {
 
 // Map returns the object as a map.
 func (obj *object) Map() (map[string]any, error) {
-	return encoding.MapFromJSON(string(obj.value))
+	return DecodeMap(obj.value)
 }
 
 // String returns a string representation of the object.
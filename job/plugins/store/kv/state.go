@@ -16,7 +16,7 @@ package kv
 
 import (
 	"github.com/cybergarage/go-job/job"
-	"github.com/cybergarage/go-job/job/encoding"
+	"github.com/google/uuid"
 )
 
 // NewInstanceStateKeyFrom creates a new key for a job instance state.
@@ -29,21 +29,31 @@ func NewInstanceStateListKey() Key {
 	return Key(instanceStatePrefix)
 }
 
+// NewInstanceStateListKeyWith returns the key prefix of the state records of the specified job instance, which are stored with unique keys.
+func NewInstanceStateListKeyWith(uuid uuid.UUID) Key {
+	return newKeyFrom(instanceStatePrefix, uuid.String())
+}
+
 // NewObjectFromInstanceState creates a new Object from a job instance state.
 func NewObjectFromInstanceState(state job.InstanceState, keySuffixes ...string) (Object, error) {
-	data, err := encoding.MapToJSON(state.Map())
+	return NewObjectFromInstanceStateWith(NewConfig(), state, keySuffixes...)
+}
+
+// NewObjectFromInstanceStateWith creates a new Object from a job instance state using the specified configuration.
+func NewObjectFromInstanceStateWith(config Config, state job.InstanceState, keySuffixes ...string) (Object, error) {
+	data, err := EncodeObjectValue(config, "instance state ("+state.Kind()+")", state.Map())
 	if err != nil {
 		return nil, err
 	}
 	return &object{
 		key:   NewInstanceStateKeyFrom(keySuffixes...),
-		value: []byte(data),
+		value: data,
 	}, nil
 }
 
 // NewInstanceStateFromBytes creates a job instance state from a byte slice.
 func NewInstanceStateFromBytes(b []byte) (job.InstanceState, error) {
-	m, err := encoding.MapFromJSON(string(b))
+	m, err := DecodeMap(b)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,55 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"github.com/cybergarage/go-job/job"
+)
+
+// NewSubscriptionKeyFrom creates a new key for a subscription.
+func NewSubscriptionKeyFrom(suffixes ...string) Key {
+	return newKeyFrom(subscriptionPrefix, suffixes...)
+}
+
+// NewSubscriptionListKey creates a new list key for subscriptions.
+func NewSubscriptionListKey() Key {
+	return Key(subscriptionPrefix)
+}
+
+// NewObjectFromSubscription creates a new object from a subscription.
+func NewObjectFromSubscription(subscription job.Subscription, keySuffixes ...string) (Object, error) {
+	return NewObjectFromSubscriptionWith(NewConfig(), subscription, keySuffixes...)
+}
+
+// NewObjectFromSubscriptionWith creates a new object from a subscription using the specified configuration.
+func NewObjectFromSubscriptionWith(config Config, subscription job.Subscription, keySuffixes ...string) (Object, error) {
+	data, err := EncodeObjectValue(config, "subscription ("+subscription.ID().String()+")", subscription.Map())
+	if err != nil {
+		return nil, err
+	}
+	return &object{
+		key:   NewSubscriptionKeyFrom(keySuffixes...),
+		value: data,
+	}, nil
+}
+
+// NewSubscriptionFromBytes creates a subscription from a byte slice.
+func NewSubscriptionFromBytes(b []byte) (job.Subscription, error) {
+	m, err := DecodeMap(b)
+	if err != nil {
+		return nil, err
+	}
+	return job.NewSubscriptionFromMap(m)
+}
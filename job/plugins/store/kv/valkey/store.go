@@ -31,10 +31,13 @@ type Store struct {
 }
 
 // NewStore returns a new memdb store instance.
-func NewStore(option StoreOption) kv.Store {
+// The codec and other store configurations can be set by the specified config options.
+func NewStore(option StoreOption, opts ...kv.ConfigOption) kv.Store {
 	return &Store{
 		Config: kv.NewConfig(
-			kv.WithUniqueKeys(false), // Use list commands
+			append([]kv.ConfigOption{
+				kv.WithUniqueKeys(false), // Use list commands
+			}, opts...)...,
 		),
 		Client: nil,
 		opt:    option,
@@ -57,6 +60,14 @@ func (store *Store) Start() error {
 	return store.Do(context.Background(), cmd.Build()).Error()
 }
 
+// Ping checks the connectivity to the valkey server.
+func (store *Store) Ping(ctx context.Context) error {
+	if store.Client == nil {
+		return kv.ErrNotReady
+	}
+	return store.Do(ctx, store.B().Ping().Build()).Error()
+}
+
 // Stop stops this memdb.
 func (store *Store) Stop() error {
 	return nil
@@ -24,10 +24,12 @@ import (
 	"github.com/cybergarage/go-job/job"
 	"github.com/cybergarage/go-job/job/plugins/store/kv"
 	"github.com/cybergarage/go-job/job/plugins/store/kvutil"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type kvStore struct {
 	kv.Store
+	payloadBytes *prometheus.HistogramVec
 }
 
 func nowTimestampSuffix() string {
@@ -37,7 +39,8 @@ func nowTimestampSuffix() string {
 // NewKvStoreWith creates a new key-value store instance.
 func NewKvStoreWith(store kv.Store) job.Store {
 	return &kvStore{
-		Store: store,
+		Store:        store,
+		payloadBytes: newPayloadBytes(),
 	}
 }
 
@@ -47,10 +50,11 @@ func (store *kvStore) EnqueueInstance(ctx context.Context, job job.Instance) err
 	if store.UniqueKeys() {
 		keySuffixes = append(keySuffixes, nowTimestampSuffix())
 	}
-	obj, err := kv.NewObjectFromInstance(job, keySuffixes...)
+	obj, err := kv.NewObjectFromInstanceWith(store, job, keySuffixes...)
 	if err != nil {
 		return err
 	}
+	store.payloadBytes.WithLabelValues(job.Kind(), objectInstance).Observe(float64(len(obj.Bytes())))
 	return store.Set(ctx, obj)
 }
 
@@ -162,38 +166,39 @@ func (store *kvStore) LogInstanceState(ctx context.Context, state job.InstanceSt
 		keySuffixes = append(keySuffixes, state.UUID().String())
 		keySuffixes = append(keySuffixes, nowTimestampSuffix())
 	}
-	obj, err := kv.NewObjectFromInstanceState(state, keySuffixes...)
+	obj, err := kv.NewObjectFromInstanceStateWith(store, state, keySuffixes...)
 	if err != nil {
 		return err
 	}
+	store.payloadBytes.WithLabelValues(state.Kind(), objectState).Observe(float64(len(obj.Bytes())))
 	return store.Set(ctx, obj)
 }
 
-// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp.
-
+// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp
+// in the order of the query, and is limited to the page of the query. The records are scanned by the UUID of the query if set, and are decoded one by one
+// to keep only the page in memory.
 func (store *kvStore) LookupInstanceHistory(ctx context.Context, query job.Query) (job.InstanceHistory, error) {
-	rs, err := store.Scan(ctx, kv.NewInstanceStateListKey())
-	if err != nil {
-		return nil, err
+	key := kv.NewInstanceStateListKey()
+	if uuid, ok := query.UUID(); ok && store.UniqueKeys() {
+		key = kv.NewInstanceStateListKeyWith(uuid)
 	}
-	objs, err := kvutil.ReadAll(rs)
+	rs, err := store.Scan(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	states := []job.InstanceState{}
-	for _, obj := range objs {
-		state, err := kv.NewInstanceStateFromBytes(obj.Bytes())
+	pager := job.NewPager[job.InstanceState](query)
+	for rs.Next() {
+		obj, err := rs.Object()
 		if err != nil {
 			return nil, err
 		}
-		if query.Matches(state) {
-			states = append(states, state)
+		state, err := kv.NewInstanceStateFromBytes(obj.Bytes())
+		if err != nil {
+			return nil, err
 		}
+		pager.Add(state)
 	}
-	sort.Slice(states, func(i, j int) bool {
-		return states[i].Timestamp().Before(states[j].Timestamp())
-	})
-	return states, nil
+	return pager.Page(), nil
 }
 
 // ClearInstanceHistory clears all state records for a job instance that match the specified filter.
@@ -238,10 +243,11 @@ func (store *kvStore) Logf(ctx context.Context, ji job.Instance, logLevel job.Lo
 		keySuffixes = append(keySuffixes, log.UUID().String())
 		keySuffixes = append(keySuffixes, nowTimestampSuffix())
 	}
-	obj, err := kv.NewObjectFromLog(log, keySuffixes...)
+	obj, err := kv.NewObjectFromLogWith(store, log, keySuffixes...)
 	if err != nil {
 		return err
 	}
+	store.payloadBytes.WithLabelValues(log.Kind(), objectLog).Observe(float64(len(obj.Bytes())))
 	return store.Set(ctx, obj)
 }
 
@@ -265,30 +271,31 @@ func (store *kvStore) Debugf(ctx context.Context, ji job.Instance, format string
 	return store.Logf(ctx, ji, job.LogDebug, format, args...)
 }
 
-// LookupInstanceLogs lists all log entries for a job instance. The returned logs are sorted by their timestamp.
+// LookupInstanceLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp
+// in the order of the query, and are limited to the page of the query. The logs are scanned by the UUID of the query if set, and are decoded one by one
+// to keep only the page in memory.
 func (store *kvStore) LookupInstanceLogs(ctx context.Context, query job.Query) ([]job.Log, error) {
-	rs, err := store.Scan(ctx, kv.NewLogListKey())
-	if err != nil {
-		return nil, err
+	key := kv.NewLogListKey()
+	if uuid, ok := query.UUID(); ok && store.UniqueKeys() {
+		key = kv.NewLogListKeyWith(uuid)
 	}
-	objs, err := kvutil.ReadAll(rs)
+	rs, err := store.Scan(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	logs := make([]job.Log, 0)
-	for _, obj := range objs {
-		log, err := kv.NewLogFromBytes(obj.Bytes())
+	pager := job.NewPager[job.Log](query)
+	for rs.Next() {
+		obj, err := rs.Object()
 		if err != nil {
 			return nil, err
 		}
-		if query.Matches(log) {
-			logs = append(logs, log)
+		log, err := kv.NewLogFromBytes(obj.Bytes())
+		if err != nil {
+			return nil, err
 		}
+		pager.Add(log)
 	}
-	sort.Slice(logs, func(i, j int) bool {
-		return logs[i].Timestamp().Before(logs[j].Timestamp())
-	})
-	return logs, nil
+	return pager.Page(), nil
 }
 
 // ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
@@ -321,11 +328,147 @@ func (store *kvStore) ClearInstanceLogs(ctx context.Context, filter job.Filter)
 	return nil
 }
 
+// LogAuditRecord adds a new audit record.
+func (store *kvStore) LogAuditRecord(ctx context.Context, record job.AuditRecord) error {
+	keySuffixes := []string{}
+	if store.UniqueKeys() {
+		keySuffixes = append(keySuffixes, record.ID().String())
+		keySuffixes = append(keySuffixes, nowTimestampSuffix())
+	}
+	obj, err := kv.NewObjectFromAuditRecordWith(store, record, keySuffixes...)
+	if err != nil {
+		return err
+	}
+	store.payloadBytes.WithLabelValues(record.Kind(), objectAudit).Observe(float64(len(obj.Bytes())))
+	return store.Set(ctx, obj)
+}
+
+// LookupAuditRecords lists all audit records that match the specified query. The returned records are sorted by their timestamp in the order of the query, and are limited to the page of the query.
+func (store *kvStore) LookupAuditRecords(ctx context.Context, query job.Query) ([]job.AuditRecord, error) {
+	rs, err := store.Scan(ctx, kv.NewAuditRecordListKey())
+	if err != nil {
+		return nil, err
+	}
+	pager := job.NewPager[job.AuditRecord](query)
+	for rs.Next() {
+		obj, err := rs.Object()
+		if err != nil {
+			return nil, err
+		}
+		record, err := kv.NewAuditRecordFromBytes(obj.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		pager.Add(record)
+	}
+	return pager.Page(), nil
+}
+
+// ClearAuditRecords clears all audit records that match the specified filter.
+func (store *kvStore) ClearAuditRecords(ctx context.Context, filter job.Filter) error {
+	if filter.IsUnset() {
+		return store.Delete(ctx, kv.NewAuditRecordListKey())
+	}
+
+	rs, err := store.Scan(ctx, kv.NewAuditRecordListKey())
+	if err != nil {
+		return err
+	}
+	objs, err := kvutil.ReadAll(rs)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		record, err := kv.NewAuditRecordFromBytes(obj.Bytes())
+		if err != nil {
+			return err
+		}
+		if !filter.Matches(record) {
+			continue
+		}
+		err = store.Remove(ctx, obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddSubscription adds a new subscription.
+func (store *kvStore) AddSubscription(ctx context.Context, subscription job.Subscription) error {
+	keySuffixes := []string{}
+	if store.UniqueKeys() {
+		keySuffixes = append(keySuffixes, subscription.ID().String())
+	}
+	obj, err := kv.NewObjectFromSubscriptionWith(store, subscription, keySuffixes...)
+	if err != nil {
+		return err
+	}
+	store.payloadBytes.WithLabelValues(subscription.Kind(), objectSubscription).Observe(float64(len(obj.Bytes())))
+	return store.Set(ctx, obj)
+}
+
+// RemoveSubscription removes the subscription with the specified identifier.
+func (store *kvStore) RemoveSubscription(ctx context.Context, id job.UUID) error {
+	rs, err := store.Scan(ctx, kv.NewSubscriptionListKey())
+	if err != nil {
+		return err
+	}
+	objs, err := kvutil.ReadAll(rs)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		subscription, err := kv.NewSubscriptionFromBytes(obj.Bytes())
+		if err != nil {
+			return err
+		}
+		if subscription.ID() != id {
+			continue
+		}
+		return store.Remove(ctx, obj)
+	}
+	return fmt.Errorf("subscription %s is %w", id, job.ErrNotFound)
+}
+
+// ListSubscriptions lists all subscriptions. The returned subscriptions are sorted by their creation time.
+func (store *kvStore) ListSubscriptions(ctx context.Context) ([]job.Subscription, error) {
+	rs, err := store.Scan(ctx, kv.NewSubscriptionListKey())
+	if err != nil {
+		return nil, err
+	}
+	objs, err := kvutil.ReadAll(rs)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := make([]job.Subscription, 0)
+	for _, obj := range objs {
+		subscription, err := kv.NewSubscriptionFromBytes(obj.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt().Before(subscriptions[j].CreatedAt())
+	})
+	return subscriptions, nil
+}
+
 // Start starts the kv store.
 func (store *kvStore) Start() error {
 	return store.Store.Start()
 }
 
+// Ping checks the connectivity to the backend of the kv store if the underlying key-value store is a job.Pinger.
+func (store *kvStore) Ping(ctx context.Context) error {
+	pinger, ok := store.Store.(job.Pinger)
+	if !ok {
+		return nil
+	}
+	return pinger.Ping(ctx)
+}
+
 // Stop stops the kv store.
 func (store *kvStore) Stop() error {
 	return store.Store.Stop()
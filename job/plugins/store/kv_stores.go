@@ -18,6 +18,7 @@ import (
 	"github.com/cybergarage/go-job/job/plugins"
 	"github.com/cybergarage/go-job/job/plugins/store/kv/etcd"
 	"github.com/cybergarage/go-job/job/plugins/store/kv/memdb"
+	"github.com/cybergarage/go-job/job/plugins/store/kv/redis"
 	"github.com/cybergarage/go-job/job/plugins/store/kv/valkey"
 )
 
@@ -31,6 +32,11 @@ func NewValkeyStore(option valkey.StoreOption) plugins.Store {
 	return NewKvStoreWith(valkey.NewStore(option))
 }
 
+// NewRedisStore creates a new Redis key-value store instance.
+func NewRedisStore(option redis.StoreOption) plugins.Store {
+	return NewKvStoreWith(redis.NewStore(option))
+}
+
 // NewEtcdStore creates a new Etcd key-value store instance.
 func NewEtcdStore(option etcd.StoreOption) plugins.Store {
 	return NewKvStoreWith(etcd.NewStore(option))
@@ -0,0 +1,55 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	labelKind   = "kind"
+	labelObject = "object"
+)
+
+const (
+	objectInstance     = "instance"
+	objectState        = "state"
+	objectLog          = "log"
+	objectAudit        = "audit"
+	objectSubscription = "subscription"
+)
+
+// newPayloadBytes returns a histogram of stored object payload sizes in bytes, labeled by job kind and object type.
+// The histogram is collected by the job manager with its namespace and constant labels.
+func newPayloadBytes() *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{ // nolint: exhaustruct
+			Name:    "store_payload_bytes",
+			Help:    "Histogram of stored object payload sizes in bytes by kind and object type",
+			Buckets: prometheus.ExponentialBuckets(64, 4, 10),
+		},
+		[]string{labelKind, labelObject},
+	)
+}
+
+// Describe sends the descriptors of the store metrics.
+func (store *kvStore) Describe(ch chan<- *prometheus.Desc) {
+	store.payloadBytes.Describe(ch)
+}
+
+// Collect sends the store metrics.
+func (store *kvStore) Collect(ch chan<- prometheus.Metric) {
+	store.payloadBytes.Collect(ch)
+}
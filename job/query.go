@@ -15,6 +15,7 @@
 package job
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,7 +35,16 @@ type Query interface {
 	// LogLevel returns the log level criterion for the query, if set.
 	LogLevel() (LogLevel, bool)
 
-	// IsUnset returns true if no query criteria are set.
+	// Limit returns the maximum number of results of the query, if set.
+	Limit() (int, bool)
+	// Offset returns the number of results skipped before the first result of the query.
+	Offset() int
+	// Order returns the order in which the results of the query are sorted by their timestamps.
+	Order() SortOrder
+	// PageToken returns the page token after which the results of the query start, if set.
+	PageToken() (PageToken, bool)
+
+	// IsUnset returns true if no query criteria are set. The limit, the offset, the order and the page token are not criteria.
 	IsUnset() bool
 
 	// Matches returns true if the specified object satisfies all query criteria.
@@ -51,6 +61,11 @@ type query struct {
 	kind  string
 	state JobState
 	level LogLevel
+
+	limit     int
+	offset    int
+	order     SortOrder
+	pageToken PageToken
 }
 
 // WithQueryUUID sets the UUID for the query.
@@ -106,13 +121,47 @@ func WithQueryAfter(after time.Time) QueryOption {
 	}
 }
 
+// WithQueryLimit sets the maximum number of results of the query. Zero or a negative limit means no limit.
+func WithQueryLimit(limit int) QueryOption {
+	return func(q *query) {
+		q.limit = limit
+	}
+}
+
+// WithQueryOffset sets the number of results skipped before the first result of the query.
+func WithQueryOffset(offset int) QueryOption {
+	return func(q *query) {
+		q.offset = offset
+	}
+}
+
+// WithQueryOrder sets the order in which the results of the query are sorted by their timestamps. The default order is SortAscending.
+func WithQueryOrder(order SortOrder) QueryOption {
+	return func(q *query) {
+		q.order = order
+	}
+}
+
+// WithQueryPageToken sets the page token after which the results of the query start.
+// The page token of the next page is returned by NextPageToken with the results of the previous page.
+func WithQueryPageToken(token PageToken) QueryOption {
+	return func(q *query) {
+		q.pageToken = token
+	}
+}
+
+// NewQuery creates a new query with the given options.
 func NewQuery(opts ...QueryOption) Query {
 	q := &query{
-		filter: newFilter(),
-		uuid:   uuid.Nil,
-		kind:   "",
-		state:  JobStateUnset,
-		level:  LogNone,
+		filter:    newFilter(),
+		uuid:      uuid.Nil,
+		kind:      "",
+		state:     JobStateUnset,
+		level:     LogNone,
+		limit:     0,
+		offset:    0,
+		order:     SortAscending,
+		pageToken: PageToken{}, // nolint:exhaustruct
 	}
 	for _, opt := range opts {
 		opt(q)
@@ -152,7 +201,36 @@ func (q *query) LogLevel() (LogLevel, bool) {
 	return q.level, true
 }
 
-// IsUnset returns true if no query criteria are set.
+// Limit returns the maximum number of results of the query, if set.
+func (q *query) Limit() (int, bool) {
+	if q.limit <= 0 {
+		return 0, false
+	}
+	return q.limit, true
+}
+
+// Offset returns the number of results skipped before the first result of the query.
+func (q *query) Offset() int {
+	if q.offset < 0 {
+		return 0
+	}
+	return q.offset
+}
+
+// Order returns the order in which the results of the query are sorted by their timestamps.
+func (q *query) Order() SortOrder {
+	return q.order
+}
+
+// PageToken returns the page token after which the results of the query start, if set.
+func (q *query) PageToken() (PageToken, bool) {
+	if q.pageToken.IsZero() {
+		return PageToken{}, false // nolint:exhaustruct
+	}
+	return q.pageToken, true
+}
+
+// IsUnset returns true if no query criteria are set. The limit, the offset, the order and the page token are not criteria.
 func (q *query) IsUnset() bool {
 	if q == nil {
 		return true
@@ -213,6 +291,41 @@ func (q *query) Matches(v any) bool {
 			return false
 		}
 		return true
+	case AuditRecord:
+		if uuid, ok := q.UUID(); ok && !slices.Contains(v.UUIDs(), uuid) {
+			return false
+		}
+		if kind, ok := q.Kind(); ok && kind != v.Kind() {
+			return false
+		}
+		if ok := !q.filter.IsUnset(); ok && !q.filter.Matches(v) {
+			return false
+		}
+		return true
 	}
 	return false
 }
+
+// newQueryCriteriaFrom returns a new query which has the same criteria as the specified query without the limit, the offset, the order and the page token.
+func newQueryCriteriaFrom(q Query) Query {
+	opts := []QueryOption{}
+	if uuid, ok := q.UUID(); ok {
+		opts = append(opts, WithQueryUUID(uuid))
+	}
+	if kind, ok := q.Kind(); ok {
+		opts = append(opts, WithQueryKind(kind))
+	}
+	if state, ok := q.State(); ok {
+		opts = append(opts, WithQueryState(state))
+	}
+	if level, ok := q.LogLevel(); ok {
+		opts = append(opts, WithQueryLogLevel(level))
+	}
+	if before, ok := q.Before(); ok {
+		opts = append(opts, WithQueryBefore(before))
+	}
+	if after, ok := q.After(); ok {
+		opts = append(opts, WithQueryAfter(after))
+	}
+	return NewQuery(opts...)
+}
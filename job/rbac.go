@@ -0,0 +1,135 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"path"
+)
+
+// Authorizer is an interface that authorizes callers of the job service.
+type Authorizer interface {
+	// Authorize returns nil if the identity is allowed to call the RPC for the job kind.
+	// The kind is empty if the request does not specify a job kind.
+	// It returns an error which wraps ErrPermissionDenied if the call is denied.
+	Authorize(id Identity, rpc string, kind string) error
+}
+
+// AccessEffect represents the effect of an access rule.
+type AccessEffect int
+
+const (
+	// AccessAllow allows matching calls.
+	AccessAllow AccessEffect = iota
+	// AccessDeny denies matching calls. Deny rules take precedence over allow rules.
+	AccessDeny
+)
+
+// String returns a string representation of the access effect.
+func (effect AccessEffect) String() string {
+	switch effect {
+	case AccessAllow:
+		return "allow"
+	case AccessDeny:
+		return "deny"
+	}
+	return fmt.Sprintf("unknown(%d)", int(effect))
+}
+
+// AccessRule represents a rule of the role-based access control policy.
+type AccessRule struct {
+	// Effect is the effect of the rule.
+	Effect AccessEffect
+	// Identities are glob patterns of identity names which the rule applies to. Empty means all identities.
+	Identities []string
+	// RPCs are glob patterns of RPC names such as ScheduleJob which the rule applies to. Empty means all RPCs.
+	RPCs []string
+	// Kinds are glob patterns of job kinds which the rule applies to. Empty means all requests including ones without a job kind.
+	// Rules with kind patterns never match requests without a job kind.
+	Kinds []string
+}
+
+// NewAllowRule returns a new rule which allows the identities to call the RPCs for the job kinds.
+func NewAllowRule(identities []string, rpcs []string, kinds []string) AccessRule {
+	return AccessRule{
+		Effect:     AccessAllow,
+		Identities: identities,
+		RPCs:       rpcs,
+		Kinds:      kinds,
+	}
+}
+
+// NewDenyRule returns a new rule which denies the identities to call the RPCs for the job kinds.
+func NewDenyRule(identities []string, rpcs []string, kinds []string) AccessRule {
+	return AccessRule{
+		Effect:     AccessDeny,
+		Identities: identities,
+		RPCs:       rpcs,
+		Kinds:      kinds,
+	}
+}
+
+func matchesAnyPattern(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, s); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the rule applies to the identity name, RPC and job kind.
+func (rule AccessRule) Matches(name string, rpc string, kind string) bool {
+	if 0 < len(rule.Identities) && !matchesAnyPattern(rule.Identities, name) {
+		return false
+	}
+	if 0 < len(rule.RPCs) && !matchesAnyPattern(rule.RPCs, rpc) {
+		return false
+	}
+	if 0 < len(rule.Kinds) && (len(kind) == 0 || !matchesAnyPattern(rule.Kinds, kind)) {
+		return false
+	}
+	return true
+}
+
+type rbac struct {
+	rules []AccessRule
+}
+
+// NewRBAC returns a new role-based access control authorizer with the specified rules.
+// A call is allowed if any allow rule matches and no deny rule matches; otherwise it is denied.
+func NewRBAC(rules ...AccessRule) Authorizer {
+	return &rbac{
+		rules: rules,
+	}
+}
+
+// Authorize returns nil if the identity is allowed to call the RPC for the job kind.
+func (authz *rbac) Authorize(id Identity, rpc string, kind string) error {
+	allowed := false
+	for _, rule := range authz.rules {
+		if !rule.Matches(id.Name(), rpc, kind) {
+			continue
+		}
+		if rule.Effect == AccessDeny {
+			return fmt.Errorf("%s is denied to call %s (%s): %w", id.Name(), rpc, kind, ErrPermissionDenied)
+		}
+		allowed = true
+	}
+	if !allowed {
+		return fmt.Errorf("%s is not allowed to call %s (%s): %w", id.Name(), rpc, kind, ErrPermissionDenied)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package job
 
 import (
 	"fmt"
+	"sync"
 )
 
 // registry is an interface that defines methods for managing job instances.
@@ -34,38 +35,44 @@ type registry interface {
 
 // registryImpl is responsible for managing job instances.
 type registryImpl struct {
+	sync.RWMutex
 	jobs map[string]Job
 }
 
 // newRegistry creates a new instance of Registry.
 func newRegistry() registry {
 	return &registryImpl{
-		jobs: make(map[string]Job),
+		RWMutex: sync.RWMutex{},
+		jobs:    make(map[string]Job),
 	}
 }
 
 // RegisterJob registers a job in the registry.
 func (reg *registryImpl) RegisterJob(job Job) error {
+	reg.Lock()
+	defer reg.Unlock()
 	if _, exists := reg.jobs[job.Kind()]; exists {
 		return fmt.Errorf("job with kind %q is already registered", job.Kind())
 	}
 	reg.jobs[job.Kind()] = job
-	mRegisteredJobs.Inc()
 	return nil
 }
 
 // UnregisterJob removes a job from the registry by its kind.
 func (reg *registryImpl) UnregisterJob(kind Kind) error {
+	reg.Lock()
+	defer reg.Unlock()
 	if _, exists := reg.jobs[kind]; !exists {
 		return fmt.Errorf("job with kind %q is not registered", kind)
 	}
 	delete(reg.jobs, kind)
-	mRegisteredJobs.Dec()
 	return nil
 }
 
 // ListJobs returns a slice of all registered jobs.
 func (reg *registryImpl) ListJobs() ([]Job, error) {
+	reg.RLock()
+	defer reg.RUnlock()
 	jobs := make([]Job, 0, len(reg.jobs))
 	for _, job := range reg.jobs {
 		jobs = append(jobs, job)
@@ -75,6 +82,8 @@ func (reg *registryImpl) ListJobs() ([]Job, error) {
 
 // LookupJob looks up a job by its kind in the registry.
 func (reg *registryImpl) LookupJob(kind Kind) (Job, bool) {
+	reg.RLock()
+	defer reg.RUnlock()
 	job, exists := reg.jobs[kind]
 	if !exists {
 		return nil, false
@@ -87,6 +96,8 @@ func (reg *registryImpl) LookupJob(kind Kind) (Job, bool) {
 
 // Clear clears all registered jobs.
 func (reg *registryImpl) Clear() error {
+	reg.Lock()
+	defer reg.Unlock()
 	reg.jobs = make(map[string]Job)
 	return nil
 }
@@ -41,21 +41,30 @@ func withRepositoryStore(store Store) repositoryOption {
 	}
 }
 
+// withRepositoryStateListener adds a listener which is called after a state change of a job instance is logged.
+func withRepositoryStateListener(listener func(InstanceState)) repositoryOption {
+	return func(r *repositoryImpl) {
+		r.stateListeners = append(r.stateListeners, listener)
+	}
+}
+
 type repositoryImpl struct {
 	registry
 	scheduler
 	History
 
-	store Store
+	store          Store
+	stateListeners []func(InstanceState)
 }
 
 // newRepository creates a new instance of Repository with the given options.
 func newRepository(opts ...repositoryOption) *repositoryImpl {
 	repo := &repositoryImpl{
-		store:     NewLocalStore(),
-		scheduler: nil,
-		registry:  nil,
-		History:   nil,
+		store:          NewLocalStore(),
+		scheduler:      nil,
+		registry:       nil,
+		History:        nil,
+		stateListeners: nil,
 	}
 
 	for _, opt := range opts {
@@ -64,7 +73,11 @@ func newRepository(opts ...repositoryOption) *repositoryImpl {
 
 	repo.registry = newRegistry()
 	repo.scheduler = newScheduler(withSchedulerStore(repo.store))
-	repo.History = newHistory(withHistoryStore(repo.store))
+	historyOpts := []historyOption{withHistoryStore(repo.store)}
+	for _, listener := range repo.stateListeners {
+		historyOpts = append(historyOpts, withHistoryStateListener(listener))
+	}
+	repo.History = newHistory(historyOpts...)
 
 	return repo
 }
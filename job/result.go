@@ -15,6 +15,7 @@
 package job
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -26,6 +27,37 @@ func newResultWith(values []any) ResultSet {
 	return ResultSet(values)
 }
 
+// newResultSetFrom creates a new ResultSet from the provided value using the specified codec.
+func newResultSetFrom(v any, codec ResultCodec) (ResultSet, error) {
+	switch v := v.(type) {
+	case ResultSet:
+		return v, nil
+	case []any:
+		return newResultWith(v), nil
+	case string:
+		return codec.Decode(v)
+	}
+	return nil, fmt.Errorf("invalid result set type: %T", v)
+}
+
+// Scan copies the result values into the values pointed at by dest.
+// The values are converted through JSON, so results restored from history can be scanned into their original types.
+func (r ResultSet) Scan(dest ...any) error {
+	if len(r) < len(dest) {
+		return fmt.Errorf("result count mismatch: expected at least %d, got %d", len(dest), len(r))
+	}
+	for n, d := range dest {
+		b, err := json.Marshal(r[n])
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, d); err != nil {
+			return fmt.Errorf("result[%d]: %w", n, err)
+		}
+	}
+	return nil
+}
+
 // String returns a string representation of the Result.
 func (r ResultSet) String() string {
 	if len(r) == 0 {
@@ -0,0 +1,66 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"encoding/json"
+)
+
+// ResultCodec is an interface that encodes and decodes result sets when they are recorded in the job history.
+// The name of the codec is recorded with the encoded result sets, so that they are decoded by the same codec.
+type ResultCodec interface {
+	// Name returns the name of the codec.
+	Name() string
+	// Encode encodes the result set into a string.
+	Encode(rs ResultSet) (string, error)
+	// Decode decodes the string into a result set.
+	Decode(s string) (ResultSet, error)
+}
+
+// jsonResultCodecName is the name of the JSON result codec.
+const jsonResultCodecName = "json"
+
+type jsonResultCodec struct{}
+
+// NewJSONResultCodec returns a result codec that encodes result sets as JSON arrays.
+func NewJSONResultCodec() ResultCodec {
+	return &jsonResultCodec{}
+}
+
+// Name returns the name of the codec.
+func (codec *jsonResultCodec) Name() string {
+	return jsonResultCodecName
+}
+
+// Encode encodes the result set into a JSON array string.
+func (codec *jsonResultCodec) Encode(rs ResultSet) (string, error) {
+	if rs == nil {
+		rs = ResultSet{}
+	}
+	b, err := json.Marshal([]any(rs))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Decode decodes the JSON array string into a result set.
+func (codec *jsonResultCodec) Decode(s string) (ResultSet, error) {
+	var values []any
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, err
+	}
+	return newResultWith(values), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJSONResultCodec(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	codec := NewJSONResultCodec()
+	s, err := codec.Encode(ResultSet{1, "a", point{X: 1, Y: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	im := newInstanceMapWith(map[string]any{resultSetKey: s, resultCodecKey: codec.Name()})
+	rs, ok := im.ResultSet(codec)
+	if !ok {
+		t.Fatalf("expected result set in map: %v", im)
+	}
+	if len(rs) != 3 {
+		t.Fatalf("expected 3 results, got %v", rs)
+	}
+
+	var n int
+	var str string
+	var p point
+	if err := rs.Scan(&n, &str, &p); err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 || str != "a" || p != (point{X: 1, Y: 2}) {
+		t.Errorf("unexpected scanned results: %v, %v, %v", n, str, p)
+	}
+
+	if err := rs.Scan(&n, &str, &p, &n); err == nil {
+		t.Errorf("expected error when scanning more values than results")
+	}
+}
+
+func TestResultSetFromLegacyString(t *testing.T) {
+	// Result sets recorded before the codec are decoded as strings, even if they look like JSON arrays.
+	tests := []struct {
+		s        string
+		expected ResultSet
+	}{
+		{"[1, 2]", ResultSet{"1", "2"}},
+		{"[foo, bar]", ResultSet{"foo", "bar"}},
+		{"[]", ResultSet{}},
+		{"raw", ResultSet{"raw"}},
+	}
+	for _, test := range tests {
+		im := newInstanceMapWith(map[string]any{resultSetKey: test.s})
+		rs, ok := im.ResultSet(NewJSONResultCodec())
+		if !ok {
+			t.Fatalf("expected result set in map: %v", im)
+		}
+		if !reflect.DeepEqual(rs, test.expected) {
+			t.Errorf("expected %#v from %s, got %#v", test.expected, test.s, rs)
+		}
+	}
+
+	// Result sets recorded with the codec are decoded by the codec.
+
+	im := newInstanceMapWith(map[string]any{resultSetKey: "[1, 2]", resultCodecKey: jsonResultCodecName})
+	rs, ok := im.ResultSet(NewJSONResultCodec())
+	if !ok {
+		t.Fatalf("expected result set in map: %v", im)
+	}
+	var a, b int
+	if err := rs.Scan(&a, &b); err != nil || a != 1 || b != 2 {
+		t.Errorf("expected 1 and 2, got %v (%v)", rs, err)
+	}
+}
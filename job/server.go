@@ -17,12 +17,18 @@ package job
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
+	"net/http"
 	"strconv"
+	"sync/atomic"
 
 	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
 	logger "github.com/cybergarage/go-logger/log"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -38,32 +44,85 @@ type Server interface {
 	Stop() error
 	// Restart restarts the job server.
 	Restart() error
+	// Reload restarts the gRPC, metrics and HTTP servers with the current configuration.
+	// The job manager keeps running, so that its store, registered jobs and processing job instances are kept.
+	Reload() error
+	// Ready returns nil if the job server is ready to serve requests. Otherwise, it returns an error which wraps ErrDraining if the server is draining,
+	// or ErrNotReady if the job manager is not ready.
+	Ready(ctx context.Context) error
+	// Drain marks the job server as draining, so that the readiness checks fail and load balancers stop routing new requests to the server.
+	// Drain pauses the workers from dequeuing job instances, and then waits until all workers finish processing the current job instances or the context is done.
+	// The server is no longer draining, and the workers are resumed after the server is started again.
+	Drain(ctx context.Context) error
+	// IsDraining returns true if the job server is draining.
+	IsDraining() bool
 }
 
 type server struct {
 	v1.UnimplementedJobServiceServer
 	*config
 
-	grpcServer    *grpc.Server
-	metricsServer *metricsServer
-	manager       Manager
-	addr          string
+	grpcServer     *grpc.Server
+	healthServer   *grpcHealthServer
+	metricsServer  *metricsServer
+	httpGateway    *httpGateway
+	manager        *manager
+	authenticators []Authenticator
+	authorizer     Authorizer
+	draining       atomic.Bool
+}
+
+// ServerOption is a function that configures a job server.
+type ServerOption func(*server)
+
+// WithAuthenticators sets the authenticators of the gRPC server. Callers are authenticated by the first authenticator which accepts their credentials.
+func WithAuthenticators(authenticators ...Authenticator) ServerOption {
+	return func(s *server) {
+		s.authenticators = authenticators
+	}
+}
+
+// WithAuthorizer sets the authorizer of the gRPC server.
+func WithAuthorizer(authorizer Authorizer) ServerOption {
+	return func(s *server) {
+		s.authorizer = authorizer
+	}
 }
 
 // NewServer returns a new job server instance.
+// Server options configure the server, and the other options are passed to the job manager.
 func NewServer(opts ...any) (Server, error) {
-	mgr, err := NewManager(opts...)
+	serverOpts := []ServerOption{}
+	mgrOpts := []any{}
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case ServerOption:
+			serverOpts = append(serverOpts, opt)
+		default:
+			mgrOpts = append(mgrOpts, opt)
+		}
+	}
+	mgr, err := newManager(mgrOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return &server{
+	server := &server{
 		config:                        newConfig(),
 		manager:                       mgr,
-		addr:                          DefaultBindAddr,
 		grpcServer:                    nil,
-		metricsServer:                 newMetricsServer(),
+		healthServer:                  nil,
+		metricsServer:                 newMetricsServer(mgr.metrics.gatherer),
+		httpGateway:                   nil,
+		authenticators:                nil,
+		authorizer:                    nil,
+		draining:                      atomic.Bool{},
 		UnimplementedJobServiceServer: v1.UnimplementedJobServiceServer{},
-	}, nil
+	}
+	server.httpGateway = newHTTPGateway(server)
+	for _, opt := range serverOpts {
+		opt(server)
+	}
+	return server, nil
 }
 
 // Manager returns the job manager associated with the server.
@@ -72,17 +131,28 @@ func (server *server) Manager() Manager {
 }
 
 func (server *server) grpcBindAddr() string {
-	return net.JoinHostPort(server.addr, strconv.Itoa(server.config.GRPCPort()))
+	return net.JoinHostPort(server.config.BindAddr(), strconv.Itoa(server.config.GRPCPort()))
 }
 
 func (server *server) grpcStart() error {
-	var err error
+	var creds credentials.TransportCredentials
+	if server.config.IsTLSEnabled() {
+		tlsConfig, err := newServerTLSConfig(server.config)
+		if err != nil {
+			return err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
 	listener, err := net.Listen("tcp", server.grpcBindAddr())
 	if err != nil {
 		return err
 	}
 
 	loggingUnaryInterceptor := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isGRPCHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
 		resp, err := handler(ctx, req)
 		if err == nil {
 			logger.Infof("gRPC Request: %s", info.FullMethod)
@@ -92,8 +162,24 @@ func (server *server) grpcStart() error {
 		return resp, err
 	}
 
-	server.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(loggingUnaryInterceptor))
+	interceptors := []grpc.UnaryServerInterceptor{
+		loggingUnaryInterceptor,
+	}
+	if server.isAuthEnabled() {
+		interceptors = append(interceptors, server.authUnaryInterceptor)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	server.grpcServer = grpc.NewServer(opts...)
 	v1.RegisterJobServiceServer(server.grpcServer, server)
+	server.healthServer = newGRPCHealthServer(server)
+	healthpb.RegisterHealthServer(server.grpcServer, server.healthServer)
 	go func() {
 		if err := server.grpcServer.Serve(listener); err != nil {
 			logger.Error(err)
@@ -105,6 +191,10 @@ func (server *server) grpcStart() error {
 
 // Stop stops the Grpc server.
 func (server *server) grpcStop() error {
+	if server.healthServer != nil {
+		server.healthServer.Shutdown()
+		server.healthServer = nil
+	}
 	if server.grpcServer != nil {
 		server.grpcServer.GracefulStop()
 		server.grpcServer = nil
@@ -113,16 +203,59 @@ func (server *server) grpcStop() error {
 	return nil
 }
 
-// Start starts the job server.
-func (server *server) Start() error {
+// startServices starts the gRPC, metrics and HTTP servers.
+func (server *server) startServices() error {
 	metricsServerStart := func() error {
-		return server.metricsServer.Start(DefaultPrometheusPort)
+		health := server.healthHandler()
+		handlers := map[string]http.Handler{
+			healthzPath: health,
+			readyzPath:  health,
+		}
+		if server.isHTTPMultiplexed() {
+			gateway := server.httpGateway.Handler()
+			handlers[httpGatewayPath] = gateway
+			handlers[httpDashboardPath] = gateway
+		}
+		server.metricsServer.Addr = server.config.BindAddr()
+		return server.metricsServer.Start(server.config.PrometheusPort(), handlers)
 	}
 	starters := []func() error{
-		server.manager.Start,
 		server.grpcStart,
 		metricsServerStart,
+		server.httpStart,
+	}
+	var errs error
+	for _, starter := range starters {
+		if err := starter(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// stopServices stops the gRPC, metrics and HTTP servers.
+func (server *server) stopServices() error {
+	stoppers := []func() error{
+		server.grpcStop,
+		server.metricsServer.Stop,
+		server.httpStop,
+	}
+	var errs error
+	for _, stopper := range stoppers {
+		if err := stopper(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// Start starts the job server.
+func (server *server) Start() error {
+	starters := []func() error{
+		server.manager.Start,
+		server.startServices,
 	}
+	server.draining.Store(false)
 	var errs error
 	for _, starter := range starters {
 		if err := starter(); err != nil {
@@ -143,8 +276,7 @@ func (server *server) Start() error {
 func (server *server) Stop() error {
 	stoppers := []func() error{
 		server.manager.Stop,
-		server.grpcStop,
-		server.metricsServer.Stop,
+		server.stopServices,
 	}
 	var errs error
 	for _, stopper := range stoppers {
@@ -170,6 +302,21 @@ func (server *server) Restart() error {
 	return server.Start()
 }
 
+// Reload restarts the gRPC, metrics and HTTP servers with the current configuration.
+// The job manager keeps running, so that its store, registered jobs and processing job instances are kept.
+func (server *server) Reload() error {
+	if err := server.stopServices(); err != nil {
+		return err
+	}
+	if err := server.startServices(); err != nil {
+		return err
+	}
+
+	logger.Infof("%s/%s (%s,%d) reloaded", ProductName, Version, server.grpcBindAddr(), server.config.PrometheusPort())
+
+	return nil
+}
+
 // GetVersion returns the version of the job server.
 func (server *server) GetVersion(ctx context.Context, req *v1.VersionRequest) (*v1.VersionResponse, error) {
 	return &v1.VersionResponse{
@@ -197,7 +344,19 @@ func (server *server) ScheduleJob(ctx context.Context, req *v1.ScheduleJobReques
 		}
 	}
 
-	postJob, err := server.Manager().ScheduleRegisteredJob(kind, opts...)
+	// Continue the trace of the caller to trace the job instance from the request
+	tracing := server.manager.tracing
+	traceCtx, span := tracing.tracer().Start(tracing.extractGRPC(ctx), TraceSpanSchedule,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(traceAttrKind.String(kind)),
+	)
+	opts = append(opts, traceCtx)
+
+	postJob, err := server.manager.scheduleRegisteredJob(grpcActor(ctx), kind, opts...)
+	if err == nil {
+		span.SetAttributes(traceAttrUUID.String(postJob.UUID().String()))
+	}
+	tracing.end(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -270,18 +429,25 @@ func (server *server) LookupInstances(ctx context.Context, req *v1.LookupInstanc
 			Arguments:    nil,
 			Results:      nil,
 			Error:        nil,
-			CreatedAt:    nil,
+			CreatedAt:    newGrpcTimestampFrom(instance.CreatedAt()),
 			ScheduledAt:  nil,
 			ProcessedAt:  nil,
 			CompletedAt:  nil,
 			TerminatedAt: nil,
 			CanceledAt:   nil,
 			TimedOutAt:   nil,
-			Attempts:     nil})
+			Attempts:     nil,
+		})
+	}
+
+	nextPageToken := ""
+	if token, ok := NextPageToken(query, allInstances); ok {
+		nextPageToken = token.String()
 	}
 
 	return &v1.LookupInstancesResponse{
-		Instances: instances,
+		Instances:     instances,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -292,7 +458,7 @@ func (server *server) CancelInstances(ctx context.Context, req *v1.CancelInstanc
 		return nil, err
 	}
 
-	allInstances, err := server.Manager().CancelInstances(query)
+	allInstances, err := server.manager.cancelInstances(grpcActor(ctx), query)
 	if err != nil {
 		return nil, err
 	}
@@ -317,10 +483,161 @@ func (server *server) CancelInstances(ctx context.Context, req *v1.CancelInstanc
 			TerminatedAt: nil,
 			CanceledAt:   nil,
 			TimedOutAt:   nil,
-			Attempts:     nil})
+			Attempts:     nil,
+		})
 	}
 
 	return &v1.CancelInstancesResponse{
 		Instances: instances,
 	}, nil
 }
+
+// WaitInstance waits until the specified job instance reaches a final state, and returns the job instance including its results or error.
+func (server *server) WaitInstance(ctx context.Context, req *v1.WaitInstanceRequest) (*v1.WaitInstanceResponse, error) {
+	uuid, err := NewUUIDFrom(req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	// Errors of the job instance itself are returned in the response, so only waiting errors are returned here.
+	finalInstance, err := server.manager.waitInstance(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := newGrpcInstanceFromInstance(finalInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.WaitInstanceResponse{
+		Instance: instance,
+	}, nil
+}
+
+// LookupAuditRecords looks up all audit records which match the specified query.
+func (server *server) LookupAuditRecords(ctx context.Context, req *v1.LookupAuditRecordsRequest) (*v1.LookupAuditRecordsResponse, error) {
+	query, err := newQueryFromGrpcQuery(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	allRecords, err := server.Manager().LookupAuditRecords(query)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*v1.AuditRecord{}
+	for _, record := range allRecords {
+		records = append(records, newGrpcAuditRecordFromAuditRecord(record))
+	}
+
+	nextPageToken := ""
+	if token, ok := NextPageToken(query, allRecords); ok {
+		nextPageToken = token.String()
+	}
+
+	return &v1.LookupAuditRecordsResponse{
+		Records:       records,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// AddSubscription adds a webhook subscription. The identifier and the creation time are assigned by the server.
+func (server *server) AddSubscription(ctx context.Context, req *v1.AddSubscriptionRequest) (*v1.AddSubscriptionResponse, error) {
+	pbSub := req.GetSubscription()
+	if pbSub == nil {
+		return nil, fmt.Errorf("subscription is %w", ErrNil)
+	}
+	states := make([]JobState, len(pbSub.GetStates()))
+	for n, pbState := range pbSub.GetStates() {
+		state, err := newStateFrom(pbState)
+		if err != nil {
+			return nil, err
+		}
+		states[n] = state
+	}
+	sub, err := NewSubscription(
+		WithSubscriptionURL(pbSub.GetUrl()),
+		WithSubscriptionKind(pbSub.GetKind()),
+		WithSubscriptionStates(states...),
+		WithSubscriptionSecret(pbSub.GetSecret()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.manager.addSubscription(grpcActor(ctx), sub)
+	if err != nil {
+		return nil, err
+	}
+
+	pbSub, err = newGrpcSubscriptionFromSubscription(sub)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AddSubscriptionResponse{
+		Subscription: pbSub,
+	}, nil
+}
+
+// RemoveSubscription removes the specified webhook subscription.
+func (server *server) RemoveSubscription(ctx context.Context, req *v1.RemoveSubscriptionRequest) (*v1.RemoveSubscriptionResponse, error) {
+	id, err := NewUUIDFromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.manager.removeSubscription(grpcActor(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RemoveSubscriptionResponse{}, nil
+}
+
+// ListSubscriptions returns all webhook subscriptions without their secrets.
+func (server *server) ListSubscriptions(ctx context.Context, req *v1.ListSubscriptionsRequest) (*v1.ListSubscriptionsResponse, error) {
+	allSubs, err := server.Manager().ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	subs := []*v1.Subscription{}
+	for _, sub := range allSubs {
+		pbSub, err := newGrpcSubscriptionFromSubscription(sub)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, pbSub)
+	}
+
+	return &v1.ListSubscriptionsResponse{
+		Subscriptions: subs,
+	}, nil
+}
+
+// GetStats returns the aggregate statistics of job instances by kind and state, the queue and the workers.
+func (server *server) GetStats(ctx context.Context, req *v1.GetStatsRequest) (*v1.GetStatsResponse, error) {
+	opts := []StatsOption{}
+	if req.Window != nil {
+		window := req.GetWindow().AsDuration()
+		if window <= 0 {
+			return nil, fmt.Errorf("window (%s) is %w", window, ErrInvalid)
+		}
+		opts = append(opts, WithStatsWindow(window))
+	}
+
+	stats, err := server.Manager().Stats(opts...)
+	if err != nil {
+		return nil, err
+	}
+	pbStats, err := newGrpcStatsFromStats(stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetStatsResponse{
+		Stats: pbStats,
+	}, nil
+}
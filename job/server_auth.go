@@ -0,0 +1,92 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"path"
+
+	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
+	logger "github.com/cybergarage/go-logger/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcRequestKind returns the job kind specified by the gRPC request, or an empty string if the request has no job kind.
+func grpcRequestKind(req any) string {
+	switch req := req.(type) {
+	case *v1.ScheduleJobRequest:
+		return req.GetKind()
+	case *v1.LookupInstancesRequest:
+		return req.GetQuery().GetKind()
+	case *v1.CancelInstancesRequest:
+		return req.GetQuery().GetKind()
+	case *v1.LookupAuditRecordsRequest:
+		return req.GetQuery().GetKind()
+	case *v1.AddSubscriptionRequest:
+		return req.GetSubscription().GetKind()
+	}
+	return ""
+}
+
+// grpcPeerAddr returns the peer address of the gRPC request context.
+func grpcPeerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
+
+// grpcActor returns the actor of the gRPC request recorded in the audit log.
+// The actor is the authenticated identity name, or the anonymous identity name with the peer address if no authenticator is set.
+func grpcActor(ctx context.Context) string {
+	if id, ok := IdentityFromContext(ctx); ok && id.Name() != AnonymousIdentityName {
+		return id.Name()
+	}
+	if addr := grpcPeerAddr(ctx); 0 < len(addr) {
+		return AnonymousIdentityName + "@" + addr
+	}
+	return AnonymousIdentityName
+}
+
+// isAuthEnabled returns true if the server has any authenticator or authorizer.
+func (server *server) isAuthEnabled() bool {
+	return 0 < len(server.authenticators) || server.authorizer != nil
+}
+
+// authUnaryInterceptor authenticates and authorizes gRPC requests. Denied requests are audited in the logs.
+// Health checks are not authenticated so that load balancers and orchestrators can probe the server.
+func (server *server) authUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if isGRPCHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	rpc := path.Base(info.FullMethod)
+	id, err := authenticate(ctx, server.authenticators)
+	if err != nil {
+		logger.Warnf("audit: unauthenticated call to %s from %s denied (%s)", rpc, grpcPeerAddr(ctx), err)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if server.authorizer != nil {
+		kind := grpcRequestKind(req)
+		if err := server.authorizer.Authorize(id, rpc, kind); err != nil {
+			logger.Warnf("audit: call to %s (kind: %q) by %s from %s denied (%s)", rpc, kind, id, grpcPeerAddr(ctx), err)
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return handler(withIdentity(ctx, id), req)
+}
@@ -0,0 +1,292 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
+	logger "github.com/cybergarage/go-logger/log"
+)
+
+const (
+	// httpDashboardPath is the path prefix of the web dashboard.
+	httpDashboardPath = "/dashboard/"
+	// dashboardRefreshInterval is the automatic refresh interval of the dashboard overview in seconds.
+	dashboardRefreshInterval = 5
+	// dashboardMaxInstances is the maximum number of job instances shown in the dashboard overview.
+	dashboardMaxInstances = 100
+)
+
+//go:embed dashboard/*.html
+var dashboardFS embed.FS
+
+var dashboardTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+	"timestamp": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Local().Format(time.DateTime)
+	},
+	"finishedAt": func(ji Instance) time.Time {
+		for _, t := range []time.Time{ji.CompletedAt(), ji.TerminatedAt(), ji.CanceledAt(), ji.TimeoutedAt()} {
+			if !t.IsZero() {
+				return t
+			}
+		}
+		return time.Time{}
+	},
+}).ParseFS(dashboardFS, "dashboard/*.html"))
+
+// dashboardStates lists the job states which can be selected in the dashboard.
+var dashboardStates = []JobState{
+	JobCreated,
+	JobScheduled,
+	JobProcessing,
+	JobCanceled,
+	JobTimedOut,
+	JobCompleted,
+	JobTerminated,
+}
+
+type dashboardPage struct {
+	Title   string
+	Version string
+	Refresh int
+}
+
+type dashboardWorker struct {
+	ID       int
+	Instance Instance
+}
+
+type dashboardJob struct {
+	Job    Job
+	Queued int
+}
+
+type dashboardIndexPage struct {
+	dashboardPage
+	Workers   []dashboardWorker
+	Jobs      []dashboardJob
+	Instances []Instance
+	Total     int
+	Truncated bool
+	Kind      string
+	State     string
+	States    []string
+}
+
+type dashboardInstancePage struct {
+	dashboardPage
+	UUID     UUID
+	Instance Instance
+	History  InstanceHistory
+	Logs     []Log
+}
+
+// dashboardIndex shows the workers, the registered jobs with their queue depths, and the latest job instances.
+// The job instances can be filtered by the kind and state query parameters.
+func (gw *httpGateway) dashboardIndex(w http.ResponseWriter, r *http.Request) {
+	if !gw.authorizeDashboard(w, r) {
+		return
+	}
+
+	mgr := gw.server.manager
+	page := dashboardIndexPage{
+		dashboardPage: dashboardPage{
+			Title:   "Dashboard",
+			Version: Version,
+			Refresh: dashboardRefreshInterval,
+		},
+		Workers:   []dashboardWorker{},
+		Jobs:      []dashboardJob{},
+		Instances: []Instance{},
+		Total:     0,
+		Truncated: false,
+		Kind:      r.URL.Query().Get("kind"),
+		State:     r.URL.Query().Get("state"),
+		States:    []string{},
+	}
+	for _, state := range dashboardStates {
+		page.States = append(page.States, state.String())
+	}
+
+	for n, worker := range mgr.Workers() {
+		ji, _ := worker.ProcessingInstance()
+		page.Workers = append(page.Workers, dashboardWorker{
+			ID:       n + 1,
+			Instance: ji,
+		})
+	}
+
+	queuedInstances, err := newInstancesFromQueue(mgr.Queue())
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+	jobs, err := mgr.ListJobs()
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+	for _, job := range jobs {
+		queued := 0
+		for _, ji := range queuedInstances {
+			if ji.Kind() == job.Kind() {
+				queued++
+			}
+		}
+		page.Jobs = append(page.Jobs, dashboardJob{
+			Job:    job,
+			Queued: queued,
+		})
+	}
+	slices.SortFunc(page.Jobs, func(a, b dashboardJob) int {
+		return strings.Compare(a.Job.Kind(), b.Job.Kind())
+	})
+
+	queryOpts := []QueryOption{
+		WithQueryLimit(dashboardMaxInstances),
+		WithQueryOrder(SortDescending),
+	}
+	var state JobState
+	if 0 < len(page.Kind) {
+		queryOpts = append(queryOpts, WithQueryKind(page.Kind))
+	}
+	if 0 < len(page.State) {
+		state, err = newStateFromString(page.State)
+		if err != nil {
+			gw.writeDashboardError(w, r, fmt.Errorf("state %q is %w", page.State, ErrInvalid))
+			return
+		}
+		queryOpts = append(queryOpts, WithQueryState(state))
+	}
+	instances, err := mgr.LookupInstances(NewQuery(queryOpts...))
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+
+	// The total number of the job instances is counted by the statistics, which include the instances changed within the window.
+	stats, err := mgr.Stats()
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+	counts := stats.Counts()
+	if 0 < len(page.Kind) {
+		counts = map[JobState]int{}
+		for _, ks := range stats.Kinds() {
+			if ks.Kind() == page.Kind {
+				counts = ks.Counts()
+			}
+		}
+	}
+	for s, n := range counts {
+		if 0 < len(page.State) && s != state {
+			continue
+		}
+		page.Total += n
+	}
+	page.Truncated = len(instances) == dashboardMaxInstances && len(instances) < page.Total
+	page.Instances = instances
+
+	gw.writeDashboard(w, r, "index", page)
+}
+
+// dashboardInstance shows the state history and logs of the job instance.
+func (gw *httpGateway) dashboardInstance(w http.ResponseWriter, r *http.Request) {
+	if !gw.authorizeDashboard(w, r) {
+		return
+	}
+
+	uuid, err := NewUUIDFrom(r.PathValue("uuid"))
+	if err != nil {
+		gw.writeDashboardError(w, r, fmt.Errorf("uuid %q is %w", r.PathValue("uuid"), ErrInvalid))
+		return
+	}
+
+	mgr := gw.server.manager
+	query := NewQuery(WithQueryUUID(uuid))
+	page := dashboardInstancePage{
+		dashboardPage: dashboardPage{
+			Title:   uuid.String(),
+			Version: Version,
+			Refresh: 0,
+		},
+		UUID:     uuid,
+		Instance: nil,
+		History:  nil,
+		Logs:     nil,
+	}
+
+	instances, err := mgr.LookupInstances(query)
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+	// The history instance is preferred to the queued instance because it has the latest state.
+	if 0 < len(instances) {
+		page.Instance = instances[len(instances)-1]
+	}
+
+	page.History, err = mgr.LookupInstanceHistory(query)
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+	page.Logs, err = mgr.LookupInstanceLogs(query)
+	if err != nil {
+		gw.writeDashboardError(w, r, err)
+		return
+	}
+
+	gw.writeDashboard(w, r, "instance", page)
+}
+
+// authorizeDashboard authenticates and authorizes the dashboard request as LookupInstances, and writes the error if the request is denied.
+func (gw *httpGateway) authorizeDashboard(w http.ResponseWriter, r *http.Request) bool {
+	req := &v1.LookupInstancesRequest{
+		Query: &v1.Query{}, // nolint:exhaustruct
+	}
+	if _, err := gw.authorize(r, v1.JobService_LookupInstances_FullMethodName, req); err != nil {
+		gw.writeDashboardError(w, r, err)
+		return false
+	}
+	return true
+}
+
+func (gw *httpGateway) writeDashboard(w http.ResponseWriter, r *http.Request, name string, page any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := dashboardTemplates.ExecuteTemplate(w, name, page); err != nil {
+		logger.Errorf("HTTP Request: %s %s (%s)", r.Method, r.URL.Path, err)
+		return
+	}
+	logger.Infof("HTTP Request: %s %s", r.Method, r.URL.Path)
+}
+
+func (gw *httpGateway) writeDashboardError(w http.ResponseWriter, r *http.Request, err error) {
+	code := newHTTPStatusFromGrpcCode(newGrpcStatusFromError(err).Code())
+	logger.Errorf("HTTP Request: %s %s (%d)", r.Method, r.URL.Path, code)
+	http.Error(w, err.Error(), code)
+}
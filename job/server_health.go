@@ -0,0 +1,189 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// healthzPath is the HTTP path of the liveness endpoint.
+	healthzPath = "/healthz"
+	// readyzPath is the HTTP path of the readiness endpoint.
+	readyzPath = "/readyz"
+	// healthCheckTimeout is the timeout of a readiness check.
+	healthCheckTimeout = 5 * time.Second
+	// healthWatchInterval is the polling interval used to check the readiness changes of watched services.
+	healthWatchInterval = 1 * time.Second
+)
+
+// Ready returns nil if the server is ready to serve requests. Otherwise, it returns an error which wraps ErrDraining if the server is draining,
+// or ErrNotReady if the job manager is not ready.
+func (server *server) Ready(ctx context.Context) error {
+	if server.IsDraining() {
+		return fmt.Errorf("server is %w", ErrDraining)
+	}
+	return server.manager.Ready(ctx)
+}
+
+// Drain marks the server as draining, so that the readiness checks fail and load balancers stop routing new requests to the server.
+// Drain pauses the workers from dequeuing job instances, and then waits until all workers finish processing the current job instances or the context is done.
+// The server is no longer draining, and the workers are resumed after the server is started again.
+func (server *server) Drain(ctx context.Context) error {
+	server.draining.Store(true)
+	server.manager.workerGroup.pause()
+	return server.manager.workerGroup.Wait(ctx)
+}
+
+// IsDraining returns true if the server is draining.
+func (server *server) IsDraining() bool {
+	return server.draining.Load()
+}
+
+// isGRPCHealthMethod returns true if the specified gRPC method is of the health service.
+func isGRPCHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// grpcHealthServer serves the gRPC health checking protocol (grpc.health.v1).
+// The overall health of the server, whose service name is empty, and the health of the job service reflect the readiness of the server.
+type grpcHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	server *server
+	done   chan struct{}
+}
+
+func newGRPCHealthServer(server *server) *grpcHealthServer {
+	return &grpcHealthServer{
+		UnimplementedHealthServer: healthpb.UnimplementedHealthServer{},
+		server:                    server,
+		done:                      make(chan struct{}),
+	}
+}
+
+// Shutdown ends all watch streams with the not serving status, so that the gRPC server can be stopped gracefully.
+func (hs *grpcHealthServer) Shutdown() {
+	close(hs.done)
+}
+
+// services returns the names of the services whose health is reported.
+func (hs *grpcHealthServer) services() []string {
+	return []string{"", v1.JobService_ServiceDesc.ServiceName}
+}
+
+// servingStatus returns the serving status of the specified service.
+func (hs *grpcHealthServer) servingStatus(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	found := false
+	for _, name := range hs.services() {
+		if name == service {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if err := hs.server.Ready(ctx); err != nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING, true
+	}
+	return healthpb.HealthCheckResponse_SERVING, true
+}
+
+// Check returns the serving status of the requested service.
+func (hs *grpcHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	servingStatus, ok := hs.servingStatus(ctx, req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "service (%s) is %s", req.GetService(), ErrNotFound)
+	}
+	return &healthpb.HealthCheckResponse{
+		Status: servingStatus,
+	}, nil
+}
+
+// List returns the serving status of all services.
+func (hs *grpcHealthServer) List(ctx context.Context, req *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	statuses := map[string]*healthpb.HealthCheckResponse{}
+	for _, service := range hs.services() {
+		servingStatus, _ := hs.servingStatus(ctx, service)
+		statuses[service] = &healthpb.HealthCheckResponse{
+			Status: servingStatus,
+		}
+	}
+	return &healthpb.HealthListResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// Watch streams the serving status of the requested service whenever the status changes.
+func (hs *grpcHealthServer) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	ctx := stream.Context()
+	lastStatus := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		servingStatus, _ := hs.servingStatus(ctx, req.GetService())
+		if servingStatus != lastStatus {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			lastStatus = servingStatus
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-hs.done:
+			if lastStatus != healthpb.HealthCheckResponse_NOT_SERVING {
+				if err := stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}); err != nil {
+					return err
+				}
+			}
+			return status.Error(codes.Unavailable, "server is stopping")
+		case <-time.After(healthWatchInterval):
+		}
+	}
+}
+
+// healthHandler returns the HTTP handler of the liveness and readiness endpoints.
+//   - GET /healthz: 200 OK while the server process is alive
+//   - GET /readyz: 200 OK if the server is ready, or 503 Service Unavailable with the reasons otherwise
+func (server *server) healthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+healthzPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("GET "+readyzPath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+		defer cancel()
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := server.Ready(ctx); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+	return mux
+}
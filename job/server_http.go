@@ -0,0 +1,604 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/cybergarage/go-job/job/api/gen/go/v1"
+	logger "github.com/cybergarage/go-logger/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// httpGatewayPath is the path prefix of the HTTP/JSON gateway API.
+	httpGatewayPath = "/" + DefaultAPIVersion + "/"
+	// httpWatchInterval is the polling interval used to check the state changes of watched job instances.
+	httpWatchInterval = 100 * time.Millisecond
+	// httpMaxRequestSize is the maximum size of HTTP request bodies.
+	httpMaxRequestSize = 4 * 1024 * 1024
+)
+
+// httpMarshalOptions are the JSON marshal options of the HTTP/JSON gateway. The JSON field names match the proto field names.
+var httpMarshalOptions = protojson.MarshalOptions{ // nolint:exhaustruct
+	UseProtoNames: true,
+}
+
+// httpGateway is an HTTP/JSON gateway which mirrors the gRPC job service.
+// The gateway serves the following endpoints, and the request and response bodies are the JSON representations of the gRPC messages:
+//   - GET  /v1/version: GetVersion
+//   - GET  /v1/jobs: ListRegisteredJobs
+//   - POST /v1/instances: ScheduleJob
+//   - GET  /v1/instances?kind=&uuid=&state=: LookupInstances
+//   - POST /v1/instances/cancel: CancelInstances
+//   - GET  /v1/instances/{uuid}/wait: WaitInstance
+//   - GET  /v1/instances/watch?kind=&uuid=&state=: Server-sent events of job instance state changes
+//   - GET  /v1/audit?kind=&uuid=: LookupAuditRecords
+//   - GET  /v1/stats?window=: GetStats
+//
+// The gateway also serves the read-only web dashboard under /dashboard/, and the /healthz and /readyz health endpoints.
+type httpGateway struct {
+	server     *server
+	httpServer *http.Server
+}
+
+func newHTTPGateway(server *server) *httpGateway {
+	return &httpGateway{
+		server:     server,
+		httpServer: nil,
+	}
+}
+
+// Handler returns the HTTP handler of the gateway.
+func (gw *httpGateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/version", gw.getVersion)
+	mux.HandleFunc("GET /v1/jobs", gw.listRegisteredJobs)
+	mux.HandleFunc("POST /v1/instances", gw.scheduleJob)
+	mux.HandleFunc("GET /v1/instances", gw.lookupInstances)
+	mux.HandleFunc("POST /v1/instances/cancel", gw.cancelInstances)
+	mux.HandleFunc("GET /v1/instances/{uuid}/wait", gw.waitInstance)
+	mux.HandleFunc("GET /v1/instances/watch", gw.watchInstances)
+	mux.HandleFunc("GET /v1/audit", gw.lookupAuditRecords)
+	mux.HandleFunc("GET /v1/stats", gw.getStats)
+	mux.HandleFunc("GET /dashboard/{$}", gw.dashboardIndex)
+	mux.HandleFunc("GET /dashboard/instances/{uuid}", gw.dashboardInstance)
+	health := gw.server.healthHandler()
+	mux.Handle(healthzPath, health)
+	mux.Handle(readyzPath, health)
+	return mux
+}
+
+// Start starts the gateway on the specified address. TLS is used if the server configuration is enabled.
+func (gw *httpGateway) Start(addr string) error {
+	if err := gw.Stop(); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	gw.httpServer = &http.Server{ // nolint:exhaustruct
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler:           gw.Handler(),
+	}
+
+	if gw.server.config.IsTLSEnabled() {
+		tlsConfig, err := newServerTLSConfig(gw.server.config)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+		gw.httpServer.TLSConfig = tlsConfig
+	}
+
+	go func(httpServer *http.Server) {
+		var err error
+		if httpServer.TLSConfig != nil {
+			err = httpServer.ServeTLS(listener, "", "")
+		} else {
+			err = httpServer.Serve(listener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(err)
+		}
+	}(gw.httpServer)
+
+	return nil
+}
+
+// Stop stops the gateway.
+func (gw *httpGateway) Stop() error {
+	if gw.httpServer == nil {
+		return nil
+	}
+	err := gw.httpServer.Close()
+	gw.httpServer = nil
+	return err
+}
+
+// newContext returns a gRPC compatible context of the HTTP request to share the authenticators, the audit actors and the trace context with the gRPC server.
+func (gw *httpGateway) newContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	md := metadata.MD{}
+	if auth := r.Header.Get(authorizationHeader); 0 < len(auth) {
+		md.Set(authorizationHeader, auth)
+	}
+	for _, field := range gw.server.manager.tracing.propagator.Fields() {
+		if value := r.Header.Get(field); 0 < len(value) {
+			md.Set(field, value)
+		}
+	}
+	if 0 < md.Len() {
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	p := &peer.Peer{ // nolint:exhaustruct
+		Addr: newHTTPAddr(r.RemoteAddr),
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{ // nolint:exhaustruct
+			State: *r.TLS,
+		}
+	}
+	return peer.NewContext(ctx, p)
+}
+
+// authorize authenticates and authorizes the request as the specified gRPC method, and returns the context with the caller identity.
+func (gw *httpGateway) authorize(r *http.Request, method string, req any) (context.Context, error) {
+	ctx := gw.newContext(r)
+	if !gw.server.isAuthEnabled() {
+		return ctx, nil
+	}
+	info := &grpc.UnaryServerInfo{ // nolint:exhaustruct
+		FullMethod: method,
+	}
+	handler := func(authCtx context.Context, req any) (any, error) {
+		ctx = authCtx
+		return nil, nil
+	}
+	if _, err := gw.server.authUnaryInterceptor(ctx, req, info, handler); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// serve authorizes and handles the request as the specified gRPC method, and writes the response message.
+func (gw *httpGateway) serve(w http.ResponseWriter, r *http.Request, method string, req proto.Message, handler grpc.UnaryHandler) {
+	ctx, err := gw.authorize(r, method, req)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	res, err := handler(ctx, req)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	msg, ok := res.(proto.Message)
+	if !ok {
+		gw.writeError(w, r, fmt.Errorf("response %T is %w", res, ErrInvalid))
+		return
+	}
+	gw.writeMessage(w, r, http.StatusOK, msg)
+}
+
+func (gw *httpGateway) getVersion(w http.ResponseWriter, r *http.Request) {
+	req := &v1.VersionRequest{}
+	gw.serve(w, r, v1.JobService_GetVersion_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.GetVersion(ctx, req.(*v1.VersionRequest))
+	})
+}
+
+func (gw *httpGateway) listRegisteredJobs(w http.ResponseWriter, r *http.Request) {
+	req := &v1.ListRegisteredJobsRequest{}
+	gw.serve(w, r, v1.JobService_ListRegisteredJobs_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.ListRegisteredJobs(ctx, req.(*v1.ListRegisteredJobsRequest))
+	})
+}
+
+func (gw *httpGateway) scheduleJob(w http.ResponseWriter, r *http.Request) {
+	req := &v1.ScheduleJobRequest{} // nolint:exhaustruct
+	if err := gw.readMessage(r, req); err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	gw.serve(w, r, v1.JobService_ScheduleJob_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.ScheduleJob(ctx, req.(*v1.ScheduleJobRequest))
+	})
+}
+
+func (gw *httpGateway) lookupInstances(w http.ResponseWriter, r *http.Request) {
+	query, err := newGrpcQueryFromHTTPRequest(r)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	req := &v1.LookupInstancesRequest{
+		Query: query,
+	}
+	gw.serve(w, r, v1.JobService_LookupInstances_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.LookupInstances(ctx, req.(*v1.LookupInstancesRequest))
+	})
+}
+
+func (gw *httpGateway) cancelInstances(w http.ResponseWriter, r *http.Request) {
+	req := &v1.CancelInstancesRequest{} // nolint:exhaustruct
+	if err := gw.readMessage(r, req); err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	if req.Query == nil {
+		req.Query = &v1.Query{} // nolint:exhaustruct
+	}
+	gw.serve(w, r, v1.JobService_CancelInstances_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.CancelInstances(ctx, req.(*v1.CancelInstancesRequest))
+	})
+}
+
+func (gw *httpGateway) waitInstance(w http.ResponseWriter, r *http.Request) {
+	req := &v1.WaitInstanceRequest{
+		Uuid: r.PathValue("uuid"),
+	}
+	gw.serve(w, r, v1.JobService_WaitInstance_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.WaitInstance(ctx, req.(*v1.WaitInstanceRequest))
+	})
+}
+
+func (gw *httpGateway) lookupAuditRecords(w http.ResponseWriter, r *http.Request) {
+	query, err := newGrpcQueryFromHTTPRequest(r)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	req := &v1.LookupAuditRecordsRequest{
+		Query: query,
+	}
+	gw.serve(w, r, v1.JobService_LookupAuditRecords_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.LookupAuditRecords(ctx, req.(*v1.LookupAuditRecordsRequest))
+	})
+}
+
+func (gw *httpGateway) getStats(w http.ResponseWriter, r *http.Request) {
+	req := &v1.GetStatsRequest{} // nolint:exhaustruct
+	if s := r.URL.Query().Get("window"); 0 < len(s) {
+		window, err := time.ParseDuration(s)
+		if err != nil {
+			gw.writeError(w, r, status.Errorf(codes.InvalidArgument, "window %q is %s", s, ErrInvalid))
+			return
+		}
+		req.Window = durationpb.New(window)
+	}
+	gw.serve(w, r, v1.JobService_GetStats_FullMethodName, req, func(ctx context.Context, req any) (any, error) {
+		return gw.server.GetStats(ctx, req.(*v1.GetStatsRequest))
+	})
+}
+
+// watchInstances streams the job instances which match the query as server-sent events whenever their states change.
+// The stream starts with the current job instances, and is authorized as LookupInstances.
+func (gw *httpGateway) watchInstances(w http.ResponseWriter, r *http.Request) {
+	pbQuery, err := newGrpcQueryFromHTTPRequest(r)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	req := &v1.LookupInstancesRequest{
+		Query: pbQuery,
+	}
+	ctx, err := gw.authorize(r, v1.JobService_LookupInstances_FullMethodName, req)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	query, err := newQueryFromGrpcQuery(pbQuery)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		gw.writeError(w, r, fmt.Errorf("streaming is %w", ErrInvalid))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	logger.Infof("HTTP Request: %s %s", r.Method, r.URL.Path)
+
+	// The history is polled incrementally from the last state record, and the state records of the watched job instances
+	// are kept until they reach a final state, so that their instances are rebuilt from the whole records.
+	watched := map[UUID]InstanceHistory{}
+	states := map[UUID]JobState{}
+	var last time.Time
+	seen := map[string]bool{}
+	for {
+		records, err := gw.server.manager.LookupHistory(newWatchQueryFrom(query, last))
+		if err != nil {
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+			flusher.Flush()
+			return
+		}
+		changed := InstanceHistory{}
+		uuids := map[UUID]bool{}
+		for _, record := range records {
+			key := fmt.Sprintf("%s:%s", record.UUID(), record.State())
+			if record.Timestamp().Equal(last) && seen[key] {
+				continue
+			}
+			if record.Timestamp().After(last) {
+				last = record.Timestamp()
+				seen = map[string]bool{}
+			}
+			seen[key] = true
+			watched[record.UUID()] = append(watched[record.UUID()], record)
+			uuids[record.UUID()] = true
+		}
+		for uuid := range uuids {
+			changed = append(changed, watched[uuid]...)
+		}
+		instances, err := newInstancesFromHistory(changed, gw.server.manager.resultCodec)
+		if err != nil {
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+			flusher.Flush()
+			return
+		}
+		for _, ji := range instances {
+			if ji.State().Is(JobStateFinal) {
+				delete(watched, ji.UUID())
+				delete(states, ji.UUID())
+			}
+			if !query.Matches(ji) {
+				continue
+			}
+			if state, ok := states[ji.UUID()]; ok && state == ji.State() {
+				continue
+			}
+			if !ji.State().Is(JobStateFinal) {
+				states[ji.UUID()] = ji.State()
+			}
+			pbInstance, err := newGrpcInstanceFromInstance(ji)
+			if err != nil {
+				continue
+			}
+			b, err := httpMarshalOptions.Marshal(pbInstance)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: instance\ndata: %s\n\n", b)
+		}
+		flusher.Flush()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(httpWatchInterval):
+		}
+	}
+}
+
+// newWatchQueryFrom returns the query to look up the state records of the watched job instances since the specified time.
+// The state criterion is applied to the rebuilt instances, so that the final state records of all instances are looked up.
+func newWatchQueryFrom(query Query, since time.Time) Query {
+	opts := []QueryOption{}
+	if uuid, ok := query.UUID(); ok {
+		opts = append(opts, WithQueryUUID(uuid))
+	}
+	if kind, ok := query.Kind(); ok {
+		opts = append(opts, WithQueryKind(kind))
+	}
+	if before, ok := query.Before(); ok {
+		opts = append(opts, WithQueryBefore(before))
+	}
+	after, ok := query.After()
+	if !since.IsZero() && (!ok || after.Before(since)) {
+		// The records at the last time are looked up again because more records may be logged at the same time.
+		after = since.Add(-time.Nanosecond)
+		ok = true
+	}
+	if ok {
+		opts = append(opts, WithQueryAfter(after))
+	}
+	return NewQuery(opts...)
+}
+
+// readMessage reads the JSON request body into the specified message. An empty body is regarded as an empty message.
+func (gw *httpGateway) readMessage(r *http.Request, msg proto.Message) error {
+	b, err := io.ReadAll(io.LimitReader(r.Body, httpMaxRequestSize))
+	if err != nil {
+		return err
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(b, msg); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (gw *httpGateway) writeMessage(w http.ResponseWriter, r *http.Request, code int, msg proto.Message) {
+	b, err := httpMarshalOptions.Marshal(msg)
+	if err != nil {
+		logger.Errorf("HTTP Request: %s %s (%s)", r.Method, r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if code == http.StatusOK {
+		logger.Infof("HTTP Request: %s %s", r.Method, r.URL.Path)
+	} else {
+		logger.Errorf("HTTP Request: %s %s (%d)", r.Method, r.URL.Path, code)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b) // nolint:errcheck
+}
+
+// writeError writes the error as the JSON representation of the gRPC status with the corresponding HTTP status code.
+func (gw *httpGateway) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	s := newGrpcStatusFromError(err)
+	gw.writeMessage(w, r, newHTTPStatusFromGrpcCode(s.Code()), s.Proto())
+}
+
+// newGrpcQueryFromHTTPRequest returns the query of the URL query parameters. The state is specified by the proto enumeration name,
+// and the order is specified by "asc" or "desc".
+func newGrpcQueryFromHTTPRequest(r *http.Request) (*v1.Query, error) {
+	params := r.URL.Query()
+	query := &v1.Query{} // nolint:exhaustruct
+	if kind := params.Get("kind"); 0 < len(kind) {
+		query.Kind = &kind
+	}
+	if uuid := params.Get("uuid"); 0 < len(uuid) {
+		query.Uuid = &uuid
+	}
+	if name := params.Get("state"); 0 < len(name) {
+		value, ok := v1.JobState_value[name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "state %q is %s", name, ErrInvalid)
+		}
+		state := v1.JobState(value)
+		query.State = &state
+	}
+	for _, param := range []struct {
+		name  string
+		value **int32
+	}{
+		{"limit", &query.Limit},
+		{"offset", &query.Offset},
+	} {
+		s := params.Get(param.name)
+		if len(s) == 0 {
+			continue
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || n < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s %q is %s", param.name, s, ErrInvalid)
+		}
+		v := int32(n)
+		*param.value = &v
+	}
+	if s := params.Get("order"); 0 < len(s) {
+		order, err := NewSortOrderFromString(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "order %q is %s", s, ErrInvalid)
+		}
+		pbOrder := newGrpcSortOrderFrom(order)
+		query.Order = &pbOrder
+	}
+	if token := params.Get("page_token"); 0 < len(token) {
+		query.PageToken = &token
+	}
+	return query, nil
+}
+
+// newGrpcStatusFromError returns the gRPC status of the specified error.
+func newGrpcStatusFromError(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalid):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUnauthenticated):
+		return status.New(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrPermissionDenied):
+		return status.New(codes.PermissionDenied, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	}
+	return status.New(codes.Internal, err.Error())
+}
+
+// newHTTPStatusFromGrpcCode returns the HTTP status code corresponding to the gRPC status code.
+func newHTTPStatusFromGrpcCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	}
+	return http.StatusInternalServerError
+}
+
+// httpAddr is a net.Addr of the remote address of an HTTP request.
+type httpAddr string
+
+func newHTTPAddr(addr string) net.Addr {
+	return httpAddr(addr)
+}
+
+// Network returns the network name of the address.
+func (addr httpAddr) Network() string {
+	return "tcp"
+}
+
+// String returns the string form of the address.
+func (addr httpAddr) String() string {
+	return string(addr)
+}
+
+// httpBindAddr returns the bind address of the HTTP gateway.
+func (server *server) httpBindAddr() string {
+	return net.JoinHostPort(server.config.BindAddr(), strconv.Itoa(server.config.HTTPPort()))
+}
+
+// isHTTPMultiplexed returns true if the HTTP gateway is served by the Prometheus metrics server.
+func (server *server) isHTTPMultiplexed() bool {
+	port := server.config.HTTPPort()
+	return port != 0 && port == server.config.PrometheusPort()
+}
+
+func (server *server) httpStart() error {
+	if server.config.HTTPPort() == 0 || server.isHTTPMultiplexed() {
+		return nil
+	}
+	return server.httpGateway.Start(server.httpBindAddr())
+}
+
+func (server *server) httpStop() error {
+	return server.httpGateway.Stop()
+}
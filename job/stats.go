@@ -0,0 +1,482 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultStatsWindow is the default window in which the completed and failed executions are counted for the statistics.
+	DefaultStatsWindow = 1 * time.Hour
+)
+
+const (
+	windowKey            = "window"
+	queueDepthKey        = "queue_depth"
+	oldestScheduledAtKey = "oldest_scheduled_at"
+	completedKey         = "completed"
+	failedKey            = "failed"
+	successRateKey       = "success_rate"
+	failureRateKey       = "failure_rate"
+	kindsKey             = "kinds"
+	workersKey           = "workers"
+	busyWorkersKey       = "busy_workers"
+	workerUtilizationKey = "worker_utilization"
+)
+
+// InstanceStats represents aggregate statistics of job instances.
+type InstanceStats interface {
+	// Counts returns the numbers of job instances by their current states, which changed within the window, are queued, or are processing.
+	Counts() map[JobState]int
+	// QueueDepth returns the number of job instances waiting in the queue.
+	QueueDepth() int
+	// OldestScheduledAt returns the scheduled time of the oldest job instance waiting in the queue, or false if the queue is empty.
+	OldestScheduledAt() (time.Time, bool)
+	// Completed returns the number of executions completed within the window.
+	Completed() int
+	// Failed returns the number of executions terminated or timed out within the window, including the failed attempts of retried job instances.
+	Failed() int
+	// SuccessRate returns the ratio of the completed executions to the completed and failed executions within the window, or 0 if no execution finished.
+	SuccessRate() float64
+	// FailureRate returns the ratio of the failed executions to the completed and failed executions within the window, or 0 if no execution finished.
+	FailureRate() float64
+	// Map returns a map representation of the statistics.
+	Map() map[string]any
+}
+
+// KindStats represents aggregate statistics of job instances of a job kind.
+type KindStats interface {
+	// Kind returns the job kind of the statistics.
+	Kind() Kind
+	// InstanceStats is the statistics of the job instances of the kind.
+	InstanceStats
+}
+
+// Stats represents aggregate statistics of job instances and workers.
+type Stats interface {
+	// InstanceStats is the statistics of the job instances of all kinds.
+	InstanceStats
+	// Timestamp returns the time when the statistics were collected.
+	Timestamp() time.Time
+	// Window returns the window in which the completed and failed executions are counted.
+	Window() time.Duration
+	// Kinds returns the statistics by job kind, sorted by kind.
+	Kinds() []KindStats
+	// NumWorkers returns the number of workers.
+	NumWorkers() int
+	// NumBusyWorkers returns the number of workers processing job instances.
+	NumBusyWorkers() int
+	// WorkerUtilization returns the ratio of the busy workers to all workers, or 0 if there is no worker.
+	WorkerUtilization() float64
+}
+
+// StatsOption is a function that configures the statistics.
+type StatsOption func(*stats)
+
+// WithStatsWindow sets the window in which the completed and failed executions are counted. The default window is DefaultStatsWindow.
+func WithStatsWindow(window time.Duration) StatsOption {
+	return func(s *stats) {
+		s.window = window
+	}
+}
+
+type instanceStats struct {
+	counts            map[JobState]int
+	queueDepth        int
+	oldestScheduledAt time.Time
+	completed         int
+	failed            int
+}
+
+func newInstanceStats() *instanceStats {
+	return &instanceStats{
+		counts:            map[JobState]int{},
+		queueDepth:        0,
+		oldestScheduledAt: time.Time{},
+		completed:         0,
+		failed:            0,
+	}
+}
+
+// addQueued counts the job instance waiting in the queue, which is scheduled at the specified time.
+func (s *instanceStats) addQueued(scheduledAt time.Time) {
+	s.queueDepth++
+	if s.oldestScheduledAt.IsZero() || scheduledAt.Before(s.oldestScheduledAt) {
+		s.oldestScheduledAt = scheduledAt
+	}
+}
+
+// addExecution counts the execution which finished in the specified state.
+func (s *instanceStats) addExecution(state JobState) {
+	switch state {
+	case JobCompleted:
+		s.completed++
+	case JobTerminated, JobTimedOut:
+		s.failed++
+	}
+}
+
+// Counts returns the numbers of job instances by their current states, which changed within the window, are queued, or are processing.
+func (s *instanceStats) Counts() map[JobState]int {
+	return s.counts
+}
+
+// QueueDepth returns the number of job instances waiting in the queue.
+func (s *instanceStats) QueueDepth() int {
+	return s.queueDepth
+}
+
+// OldestScheduledAt returns the scheduled time of the oldest job instance waiting in the queue, or false if the queue is empty.
+func (s *instanceStats) OldestScheduledAt() (time.Time, bool) {
+	if s.queueDepth == 0 {
+		return time.Time{}, false
+	}
+	return s.oldestScheduledAt, true
+}
+
+// Completed returns the number of executions completed within the window.
+func (s *instanceStats) Completed() int {
+	return s.completed
+}
+
+// Failed returns the number of executions terminated or timed out within the window, including the failed attempts of retried job instances.
+func (s *instanceStats) Failed() int {
+	return s.failed
+}
+
+// SuccessRate returns the ratio of the completed executions to the completed and failed executions within the window, or 0 if no execution finished.
+func (s *instanceStats) SuccessRate() float64 {
+	if s.completed+s.failed == 0 {
+		return 0
+	}
+	return float64(s.completed) / float64(s.completed+s.failed)
+}
+
+// FailureRate returns the ratio of the failed executions to the completed and failed executions within the window, or 0 if no execution finished.
+func (s *instanceStats) FailureRate() float64 {
+	if s.completed+s.failed == 0 {
+		return 0
+	}
+	return float64(s.failed) / float64(s.completed+s.failed)
+}
+
+// Map returns a map representation of the statistics.
+func (s *instanceStats) Map() map[string]any {
+	states := map[string]any{}
+	for state, count := range s.counts {
+		states[state.String()] = count
+	}
+	m := map[string]any{
+		statesKey:      states,
+		queueDepthKey:  s.queueDepth,
+		completedKey:   s.completed,
+		failedKey:      s.failed,
+		successRateKey: s.SuccessRate(),
+		failureRateKey: s.FailureRate(),
+	}
+	if oldest, ok := s.OldestScheduledAt(); ok {
+		m[oldestScheduledAtKey] = NewTimestampFromTime(oldest).String()
+	}
+	return m
+}
+
+type kindStats struct {
+	*instanceStats
+	kind Kind
+}
+
+// Kind returns the job kind of the statistics.
+func (s *kindStats) Kind() Kind {
+	return s.kind
+}
+
+// Map returns a map representation of the statistics.
+func (s *kindStats) Map() map[string]any {
+	m := s.instanceStats.Map()
+	m[kindKey] = s.kind
+	return m
+}
+
+type stats struct {
+	*instanceStats
+	ts          time.Time
+	window      time.Duration
+	kinds       map[Kind]*kindStats
+	workers     int
+	busyWorkers int
+}
+
+func newStats(opts ...StatsOption) *stats {
+	s := &stats{
+		instanceStats: newInstanceStats(),
+		ts:            time.Now(),
+		window:        DefaultStatsWindow,
+		kinds:         map[Kind]*kindStats{},
+		workers:       0,
+		busyWorkers:   0,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// kindStats returns the statistics of the specified kind, which is added if not found.
+func (s *stats) kindStats(kind Kind) *kindStats {
+	ks, ok := s.kinds[kind]
+	if !ok {
+		ks = &kindStats{
+			instanceStats: newInstanceStats(),
+			kind:          kind,
+		}
+		s.kinds[kind] = ks
+	}
+	return ks
+}
+
+// Timestamp returns the time when the statistics were collected.
+func (s *stats) Timestamp() time.Time {
+	return s.ts
+}
+
+// Window returns the window in which the completed and failed executions are counted.
+func (s *stats) Window() time.Duration {
+	return s.window
+}
+
+// Kinds returns the statistics by job kind, sorted by kind.
+func (s *stats) Kinds() []KindStats {
+	kinds := make([]KindStats, 0, len(s.kinds))
+	for _, ks := range s.kinds {
+		kinds = append(kinds, ks)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].Kind() < kinds[j].Kind()
+	})
+	return kinds
+}
+
+// NumWorkers returns the number of workers.
+func (s *stats) NumWorkers() int {
+	return s.workers
+}
+
+// NumBusyWorkers returns the number of workers processing job instances.
+func (s *stats) NumBusyWorkers() int {
+	return s.busyWorkers
+}
+
+// WorkerUtilization returns the ratio of the busy workers to all workers, or 0 if there is no worker.
+func (s *stats) WorkerUtilization() float64 {
+	if s.workers == 0 {
+		return 0
+	}
+	return float64(s.busyWorkers) / float64(s.workers)
+}
+
+// Map returns a map representation of the statistics.
+func (s *stats) Map() map[string]any {
+	m := s.instanceStats.Map()
+	kinds := []any{}
+	for _, ks := range s.Kinds() {
+		kinds = append(kinds, ks.Map())
+	}
+	m[timestampKey] = NewTimestampFromTime(s.ts).String()
+	m[windowKey] = s.window.String()
+	m[kindsKey] = kinds
+	m[workersKey] = s.workers
+	m[busyWorkersKey] = s.busyWorkers
+	m[workerUtilizationKey] = s.WorkerUtilization()
+	return m
+}
+
+// String returns the string representation of the statistics.
+func (s *stats) String() string {
+	return fmt.Sprintf("%v", s.Map())
+}
+
+// NewStatsFromMap creates a new statistics from the specified map, which is the map representation of the statistics.
+func NewStatsFromMap(m map[string]any) (Stats, error) {
+	total, err := newInstanceStatsFromMap(m)
+	if err != nil {
+		return nil, err
+	}
+	s := newStats()
+	s.instanceStats = total
+	for key, value := range m {
+		switch key {
+		case timestampKey:
+			ts, err := NewTimestampFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			s.ts = ts.Time()
+		case windowKey:
+			v, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w stats window: %v", ErrInvalid, value)
+			}
+			window, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, err
+			}
+			s.window = window
+		case kindsKey:
+			values, ok := value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%w stats kinds: %v", ErrInvalid, value)
+			}
+			for _, v := range values {
+				km, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("%w stats kind: %v", ErrInvalid, v)
+				}
+				kind, ok := km[kindKey].(string)
+				if !ok {
+					return nil, fmt.Errorf("%w stats kind: %v", ErrInvalid, km[kindKey])
+				}
+				ks, err := newInstanceStatsFromMap(km)
+				if err != nil {
+					return nil, err
+				}
+				s.kindStats(kind).instanceStats = ks
+			}
+		case workersKey:
+			n, err := newStatsCountFrom(key, value)
+			if err != nil {
+				return nil, err
+			}
+			s.workers = n
+		case busyWorkersKey:
+			n, err := newStatsCountFrom(key, value)
+			if err != nil {
+				return nil, err
+			}
+			s.busyWorkers = n
+		}
+	}
+	return s, nil
+}
+
+func newInstanceStatsFromMap(m map[string]any) (*instanceStats, error) {
+	s := newInstanceStats()
+	for key, value := range m {
+		var err error
+		switch key {
+		case statesKey:
+			states, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w stats states: %v", ErrInvalid, value)
+			}
+			for name, count := range states {
+				state, err := newStateFrom(name)
+				if err != nil {
+					return nil, err
+				}
+				s.counts[state], err = newStatsCountFrom(name, count)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case queueDepthKey:
+			s.queueDepth, err = newStatsCountFrom(key, value)
+		case completedKey:
+			s.completed, err = newStatsCountFrom(key, value)
+		case failedKey:
+			s.failed, err = newStatsCountFrom(key, value)
+		case oldestScheduledAtKey:
+			var ts Timestamp
+			ts, err = NewTimestampFrom(value)
+			s.oldestScheduledAt = ts.Time()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// newStatsCountFrom returns the count of the specified key, which is decoded as a float64 from JSON.
+func newStatsCountFrom(key string, value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("%w stats %s: %v", ErrInvalid, key, value)
+	}
+}
+
+// Stats returns the aggregate statistics of the job instances by kind and state, the queue and the workers.
+// Only the state records within the window are looked up, so that the cost does not grow with the whole history.
+// The job instances which changed their states within the window, are queued, or are processing are counted by their latest states,
+// and the executions which finished within the window are counted for the success and failure rates.
+func (mgr *manager) Stats(opts ...StatsOption) (Stats, error) {
+	s := newStats(opts...)
+	since := s.ts.Add(-s.window)
+
+	type latestState struct {
+		kind  string
+		state JobState
+	}
+	latestStates := map[UUID]latestState{}
+
+	history, err := mgr.LookupHistory(NewQuery(WithQueryAfter(since)))
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range history {
+		latestStates[state.UUID()] = latestState{kind: state.Kind(), state: state.State()}
+		s.addExecution(state.State())
+		s.kindStats(state.Kind()).addExecution(state.State())
+	}
+
+	queuedInstances, err := mgr.Queue().List(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, ji := range queuedInstances {
+		// The queued instances may keep their states at enqueueing, so they are counted as scheduled.
+		latestStates[ji.UUID()] = latestState{kind: ji.Kind(), state: JobScheduled}
+		scheduledAt := ji.ScheduledAt()
+		if scheduledAt.IsZero() {
+			scheduledAt = ji.CreatedAt()
+		}
+		s.addQueued(scheduledAt)
+		s.kindStats(ji.Kind()).addQueued(scheduledAt)
+	}
+
+	for _, worker := range mgr.Workers() {
+		s.workers++
+		if worker.IsProcessing() {
+			s.busyWorkers++
+		}
+		if ji, ok := worker.ProcessingInstance(); ok {
+			latestStates[ji.UUID()] = latestState{kind: ji.Kind(), state: JobProcessing}
+		}
+	}
+
+	for _, state := range latestStates {
+		s.counts[state.state]++
+		s.kindStats(state.kind).counts[state.state]++
+	}
+
+	return s, nil
+}
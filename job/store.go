@@ -26,6 +26,10 @@ type Store interface {
 	QueueStore
 	// HistoryStore provides methods for managing job instance state history.
 	HistoryStore
+	// AuditStore provides methods for managing audit records.
+	AuditStore
+	// SubscriptionStore provides methods for managing webhook subscriptions.
+	SubscriptionStore
 	// Start starts the store.
 	Start() error
 	// Stop stops the store.
@@ -60,7 +64,8 @@ type HistoryStore interface {
 type StateStore interface {
 	// LogInstanceState adds a new state record for a job instance.
 	LogInstanceState(ctx context.Context, state InstanceState) error
-	// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp.
+	// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp
+	// in the order of the query, and is limited to the page of the query. Stores can use Pager to apply the order and the page.
 	LookupInstanceHistory(ctx context.Context, query Query) (InstanceHistory, error)
 	// ClearInstanceHistory clears all state records for a job instance that match the specified filter.
 	ClearInstanceHistory(ctx context.Context, filter Filter) error
@@ -76,8 +81,30 @@ type LogStore interface {
 	Errorf(ctx context.Context, job Instance, format string, args ...any) error
 	// Debugf logs a debug message for a job instance.
 	Debugf(ctx context.Context, job Instance, format string, args ...any) error
-	// LookupInstanceLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp.
+	// LookupInstanceLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp
+	// in the order of the query, and are limited to the page of the query. Stores can use Pager to apply the order and the page.
 	LookupInstanceLogs(ctx context.Context, query Query) ([]Log, error)
 	// ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
 	ClearInstanceLogs(ctx context.Context, filter Filter) error
 }
+
+// AuditStore is an interface that defines methods for managing audit records of administrative operations.
+type AuditStore interface {
+	// LogAuditRecord adds a new audit record.
+	LogAuditRecord(ctx context.Context, record AuditRecord) error
+	// LookupAuditRecords lists all audit records that match the specified query. The returned records are sorted by their timestamp
+	// in the order of the query, and are limited to the page of the query. Stores can use Pager to apply the order and the page.
+	LookupAuditRecords(ctx context.Context, query Query) ([]AuditRecord, error)
+	// ClearAuditRecords clears all audit records that match the specified filter.
+	ClearAuditRecords(ctx context.Context, filter Filter) error
+}
+
+// SubscriptionStore is an interface that defines methods for managing webhook subscriptions of job instance state changes.
+type SubscriptionStore interface {
+	// AddSubscription adds a new subscription.
+	AddSubscription(ctx context.Context, subscription Subscription) error
+	// RemoveSubscription removes the subscription with the specified identifier. It returns an error which wraps ErrNotFound if the subscription does not exist.
+	RemoveSubscription(ctx context.Context, id UUID) error
+	// ListSubscriptions lists all subscriptions. The returned subscriptions are sorted by their creation time.
+	ListSubscriptions(ctx context.Context) ([]Subscription, error)
+}
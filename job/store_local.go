@@ -27,6 +27,8 @@ type localStore struct {
 	jobs    sync.Map
 	history []InstanceState
 	logs    []Log
+	audits  []AuditRecord
+	subs    []Subscription
 }
 
 // NewLocalStore creates a new in-memory job store.
@@ -36,6 +38,8 @@ func NewLocalStore() Store {
 		jobs:    sync.Map{},
 		history: []InstanceState{},
 		logs:    []Log{},
+		audits:  []AuditRecord{},
+		subs:    []Subscription{},
 	}
 }
 
@@ -110,17 +114,15 @@ func (store *localStore) LogInstanceState(ctx context.Context, state InstanceSta
 	return nil
 }
 
-// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp.
+// LookupInstanceHistory lists all state records for a job instance that match the specified query. The returned history is sorted by their timestamp in the order of the query, and is limited to the page of the query.
 func (store *localStore) LookupInstanceHistory(ctx context.Context, query Query) (InstanceHistory, error) {
 	store.Lock()
 	defer store.Unlock()
-	var records []InstanceState
+	pager := NewPager[InstanceState](query)
 	for _, record := range store.history {
-		if query.Matches(record) {
-			records = append(records, record)
-		}
+		pager.Add(record)
 	}
-	return records, nil
+	return pager.Page(), nil
 }
 
 // ClearInstanceHistory clears all state records for a job instance that match the specified filter.
@@ -172,17 +174,15 @@ func (store *localStore) Debugf(ctx context.Context, job Instance, format string
 	return store.Logf(ctx, job, LogDebug, format, args...)
 }
 
-// LookupInstanceLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp.
+// LookupInstanceLogs lists all log entries for a job instance that match the specified query. The returned logs are sorted by their timestamp in the order of the query, and are limited to the page of the query.
 func (store *localStore) LookupInstanceLogs(ctx context.Context, query Query) ([]Log, error) {
 	store.Lock()
 	defer store.Unlock()
-	var logs []Log
+	pager := NewPager[Log](query)
 	for _, log := range store.logs {
-		if query.Matches(log) {
-			logs = append(logs, log)
-		}
+		pager.Add(log)
 	}
-	return logs, nil
+	return pager.Page(), nil
 }
 
 // ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
@@ -200,6 +200,70 @@ func (store *localStore) ClearInstanceLogs(ctx context.Context, filter Filter) e
 	return nil
 }
 
+// LogAuditRecord adds a new audit record.
+func (store *localStore) LogAuditRecord(ctx context.Context, record AuditRecord) error {
+	store.Lock()
+	defer store.Unlock()
+	store.audits = append(store.audits, record)
+	return nil
+}
+
+// LookupAuditRecords lists all audit records that match the specified query. The returned records are sorted by their timestamp in the order of the query, and are limited to the page of the query.
+func (store *localStore) LookupAuditRecords(ctx context.Context, query Query) ([]AuditRecord, error) {
+	store.Lock()
+	defer store.Unlock()
+	pager := NewPager[AuditRecord](query)
+	for _, record := range store.audits {
+		pager.Add(record)
+	}
+	return pager.Page(), nil
+}
+
+// ClearAuditRecords clears all audit records that match the specified filter.
+func (store *localStore) ClearAuditRecords(ctx context.Context, filter Filter) error {
+	store.Lock()
+	defer store.Unlock()
+	records := []AuditRecord{}
+	for _, record := range store.audits {
+		if filter.Matches(record) {
+			continue
+		}
+		records = append(records, record)
+	}
+	store.audits = records
+	return nil
+}
+
+// AddSubscription adds a new subscription.
+func (store *localStore) AddSubscription(ctx context.Context, subscription Subscription) error {
+	store.Lock()
+	defer store.Unlock()
+	store.subs = append(store.subs, subscription)
+	return nil
+}
+
+// RemoveSubscription removes the subscription with the specified identifier. It returns an error which wraps ErrNotFound if the subscription does not exist.
+func (store *localStore) RemoveSubscription(ctx context.Context, id UUID) error {
+	store.Lock()
+	defer store.Unlock()
+	for n, sub := range store.subs {
+		if sub.ID() == id {
+			store.subs = append(store.subs[:n], store.subs[n+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("subscription %s is %w", id, ErrNotFound)
+}
+
+// ListSubscriptions lists all subscriptions. The returned subscriptions are sorted by their creation time.
+func (store *localStore) ListSubscriptions(ctx context.Context) ([]Subscription, error) {
+	store.Lock()
+	defer store.Unlock()
+	subs := make([]Subscription, len(store.subs))
+	copy(subs, store.subs)
+	return subs, nil
+}
+
 // Start starts the local store.
 func (store *localStore) Start() error {
 	// No specific start logic for local store
@@ -218,5 +282,7 @@ func (store *localStore) Clear() error {
 	defer store.Unlock()
 	store.history = []InstanceState{}
 	store.logs = []Log{}
+	store.audits = []AuditRecord{}
+	store.subs = []Subscription{}
 	return nil
 }
@@ -0,0 +1,169 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"time"
+)
+
+// meteredStore is a store which records the durations of the operations of the underlying store.
+type meteredStore struct {
+	Store
+	metrics *metrics
+}
+
+// newMeteredStore returns a store which records the durations of the operations of the specified store in the metrics.
+func newMeteredStore(store Store, m *metrics) Store {
+	return &meteredStore{
+		Store:   store,
+		metrics: m,
+	}
+}
+
+// observe records the duration of the specified operation since the start time.
+func (store *meteredStore) observe(operation string, startedAt time.Time) {
+	store.metrics.storeOperation(store.Name(), operation, startedAt)
+}
+
+// EnqueueInstance stores a job instance in the store.
+func (store *meteredStore) EnqueueInstance(ctx context.Context, job Instance) error {
+	defer store.observe("EnqueueInstance", time.Now())
+	return store.Store.EnqueueInstance(ctx, job)
+}
+
+// DequeueInstance removes a specific job instance from the store.
+func (store *meteredStore) DequeueInstance(ctx context.Context, job Instance) error {
+	defer store.observe("DequeueInstance", time.Now())
+	return store.Store.DequeueInstance(ctx, job)
+}
+
+// DequeueNextInstance retrieves and removes the highest priority job instance from the store. If no job instance is available, it returns nil.
+func (store *meteredStore) DequeueNextInstance(ctx context.Context) (Instance, error) {
+	defer store.observe("DequeueNextInstance", time.Now())
+	return store.Store.DequeueNextInstance(ctx)
+}
+
+// ListInstances lists all job instances in the store.
+func (store *meteredStore) ListInstances(ctx context.Context) ([]Instance, error) {
+	defer store.observe("ListInstances", time.Now())
+	return store.Store.ListInstances(ctx)
+}
+
+// ClearInstances clears all job instances in the store.
+func (store *meteredStore) ClearInstances(ctx context.Context) error {
+	defer store.observe("ClearInstances", time.Now())
+	return store.Store.ClearInstances(ctx)
+}
+
+// LogInstanceState adds a new state record for a job instance.
+func (store *meteredStore) LogInstanceState(ctx context.Context, state InstanceState) error {
+	defer store.observe("LogInstanceState", time.Now())
+	return store.Store.LogInstanceState(ctx, state)
+}
+
+// LookupInstanceHistory lists all state records for a job instance that match the specified query.
+func (store *meteredStore) LookupInstanceHistory(ctx context.Context, query Query) (InstanceHistory, error) {
+	defer store.observe("LookupInstanceHistory", time.Now())
+	return store.Store.LookupInstanceHistory(ctx, query)
+}
+
+// ClearInstanceHistory clears all state records for a job instance that match the specified filter.
+func (store *meteredStore) ClearInstanceHistory(ctx context.Context, filter Filter) error {
+	defer store.observe("ClearInstanceHistory", time.Now())
+	return store.Store.ClearInstanceHistory(ctx, filter)
+}
+
+// Infof logs an informational message for a job instance.
+func (store *meteredStore) Infof(ctx context.Context, job Instance, format string, args ...any) error {
+	defer store.observe("Infof", time.Now())
+	return store.Store.Infof(ctx, job, format, args...)
+}
+
+// Warnf logs a warning message for a job instance.
+func (store *meteredStore) Warnf(ctx context.Context, job Instance, format string, args ...any) error {
+	defer store.observe("Warnf", time.Now())
+	return store.Store.Warnf(ctx, job, format, args...)
+}
+
+// Errorf logs an error message for a job instance.
+func (store *meteredStore) Errorf(ctx context.Context, job Instance, format string, args ...any) error {
+	defer store.observe("Errorf", time.Now())
+	return store.Store.Errorf(ctx, job, format, args...)
+}
+
+// Debugf logs a debug message for a job instance.
+func (store *meteredStore) Debugf(ctx context.Context, job Instance, format string, args ...any) error {
+	defer store.observe("Debugf", time.Now())
+	return store.Store.Debugf(ctx, job, format, args...)
+}
+
+// LookupInstanceLogs lists all log entries for a job instance that match the specified query.
+func (store *meteredStore) LookupInstanceLogs(ctx context.Context, query Query) ([]Log, error) {
+	defer store.observe("LookupInstanceLogs", time.Now())
+	return store.Store.LookupInstanceLogs(ctx, query)
+}
+
+// ClearInstanceLogs clears all log entries for a job instance that match the specified filter.
+func (store *meteredStore) ClearInstanceLogs(ctx context.Context, filter Filter) error {
+	defer store.observe("ClearInstanceLogs", time.Now())
+	return store.Store.ClearInstanceLogs(ctx, filter)
+}
+
+// LogAuditRecord adds a new audit record.
+func (store *meteredStore) LogAuditRecord(ctx context.Context, record AuditRecord) error {
+	defer store.observe("LogAuditRecord", time.Now())
+	return store.Store.LogAuditRecord(ctx, record)
+}
+
+// LookupAuditRecords lists all audit records that match the specified query.
+func (store *meteredStore) LookupAuditRecords(ctx context.Context, query Query) ([]AuditRecord, error) {
+	defer store.observe("LookupAuditRecords", time.Now())
+	return store.Store.LookupAuditRecords(ctx, query)
+}
+
+// ClearAuditRecords clears all audit records that match the specified filter.
+func (store *meteredStore) ClearAuditRecords(ctx context.Context, filter Filter) error {
+	defer store.observe("ClearAuditRecords", time.Now())
+	return store.Store.ClearAuditRecords(ctx, filter)
+}
+
+// AddSubscription adds a new subscription.
+func (store *meteredStore) AddSubscription(ctx context.Context, subscription Subscription) error {
+	defer store.observe("AddSubscription", time.Now())
+	return store.Store.AddSubscription(ctx, subscription)
+}
+
+// RemoveSubscription removes the subscription with the specified identifier.
+func (store *meteredStore) RemoveSubscription(ctx context.Context, id UUID) error {
+	defer store.observe("RemoveSubscription", time.Now())
+	return store.Store.RemoveSubscription(ctx, id)
+}
+
+// ListSubscriptions lists all subscriptions.
+func (store *meteredStore) ListSubscriptions(ctx context.Context) ([]Subscription, error) {
+	defer store.observe("ListSubscriptions", time.Now())
+	return store.Store.ListSubscriptions(ctx)
+}
+
+// Ping checks the connectivity of the underlying store if the store is a Pinger.
+func (store *meteredStore) Ping(ctx context.Context) error {
+	pinger, ok := store.Store.(Pinger)
+	if !ok {
+		return nil
+	}
+	defer store.observe("Ping", time.Now())
+	return pinger.Ping(ctx)
+}
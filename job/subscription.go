@@ -0,0 +1,269 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	idKey        = "id"
+	urlKey       = "url"
+	statesKey    = "states"
+	secretKey    = "secret"
+	createdAtKey = "created_at"
+)
+
+// Subscription represents a webhook subscription which is notified of state changes of job instances.
+type Subscription interface {
+	// ID returns the identifier of the subscription.
+	ID() UUID
+	// URL returns the webhook endpoint URL of the subscription.
+	URL() string
+	// Kind returns the glob pattern of job kinds to notify, such as "billing.*". An empty pattern matches all job kinds.
+	Kind() string
+	// States returns the job states to notify. No states matches all job states.
+	States() []JobState
+	// Secret returns the secret key used to sign the notification payloads, or an empty string if the payloads are not signed.
+	Secret() string
+	// CreatedAt returns the time when the subscription was created.
+	CreatedAt() time.Time
+	// Matches returns true if the subscription is notified of the state change of the job instance.
+	Matches(state InstanceState) bool
+	// Map returns a map representation of the subscription. The secret is included only if it is set.
+	Map() map[string]any
+	// String returns the string representation of the subscription.
+	String() string
+}
+
+type subscription struct {
+	id        UUID
+	url       string
+	kind      string
+	states    []JobState
+	secret    string
+	createdAt time.Time
+}
+
+// SubscriptionOption defines a function that configures a subscription.
+type SubscriptionOption func(*subscription)
+
+// WithSubscriptionID sets the identifier of the subscription.
+func WithSubscriptionID(id UUID) SubscriptionOption {
+	return func(s *subscription) {
+		s.id = id
+	}
+}
+
+// WithSubscriptionURL sets the webhook endpoint URL of the subscription.
+func WithSubscriptionURL(url string) SubscriptionOption {
+	return func(s *subscription) {
+		s.url = url
+	}
+}
+
+// WithSubscriptionKind sets the glob pattern of job kinds to notify, such as "billing.*".
+func WithSubscriptionKind(kind string) SubscriptionOption {
+	return func(s *subscription) {
+		s.kind = kind
+	}
+}
+
+// WithSubscriptionStates sets the job states to notify.
+func WithSubscriptionStates(states ...JobState) SubscriptionOption {
+	return func(s *subscription) {
+		s.states = states
+	}
+}
+
+// WithSubscriptionSecret sets the secret key used to sign the notification payloads with HMAC-SHA256.
+func WithSubscriptionSecret(secret string) SubscriptionOption {
+	return func(s *subscription) {
+		s.secret = secret
+	}
+}
+
+// WithSubscriptionCreatedAt sets the time when the subscription was created.
+func WithSubscriptionCreatedAt(t time.Time) SubscriptionOption {
+	return func(s *subscription) {
+		s.createdAt = t
+	}
+}
+
+// NewSubscription creates a new subscription with the specified options.
+// It returns an error which wraps ErrInvalid if the URL is not an HTTP or HTTPS URL, or the kind pattern is malformed.
+func NewSubscription(opts ...SubscriptionOption) (Subscription, error) {
+	s := &subscription{
+		id:        NewUUID(),
+		url:       "",
+		kind:      "",
+		states:    []JobState{},
+		secret:    "",
+		createdAt: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	u, err := url.Parse(s.url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return nil, fmt.Errorf("subscription url %q is %w", s.url, ErrInvalid)
+	}
+	if _, err := path.Match(s.kind, ""); err != nil {
+		return nil, fmt.Errorf("subscription kind %q is %w (%w)", s.kind, ErrInvalid, err)
+	}
+	return s, nil
+}
+
+// NewSubscriptionFromMap creates a new subscription from a map representation.
+func NewSubscriptionFromMap(m map[string]any) (Subscription, error) {
+	opts := []SubscriptionOption{}
+	for key, value := range m {
+		switch key {
+		case idKey:
+			id, err := NewUUIDFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithSubscriptionID(id))
+		case urlKey:
+			if url, ok := value.(string); ok {
+				opts = append(opts, WithSubscriptionURL(url))
+			}
+		case kindKey:
+			if kind, ok := value.(string); ok {
+				opts = append(opts, WithSubscriptionKind(kind))
+			}
+		case statesKey:
+			var values []any
+			switch v := value.(type) {
+			case []any:
+				values = v
+			case []string:
+				for _, s := range v {
+					values = append(values, s)
+				}
+			default:
+				return nil, fmt.Errorf("%w subscription states: %v", ErrInvalid, value)
+			}
+			states := make([]JobState, 0, len(values))
+			for _, v := range values {
+				state, err := newStateFrom(v)
+				if err != nil {
+					return nil, err
+				}
+				states = append(states, state)
+			}
+			opts = append(opts, WithSubscriptionStates(states...))
+		case secretKey:
+			if secret, ok := value.(string); ok {
+				opts = append(opts, WithSubscriptionSecret(secret))
+			}
+		case createdAtKey:
+			ts, err := NewTimestampFrom(value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithSubscriptionCreatedAt(ts.Time()))
+		}
+	}
+	return NewSubscription(opts...)
+}
+
+// ID returns the identifier of the subscription.
+func (s *subscription) ID() UUID {
+	return s.id
+}
+
+// URL returns the webhook endpoint URL of the subscription.
+func (s *subscription) URL() string {
+	return s.url
+}
+
+// Kind returns the glob pattern of job kinds to notify, such as "billing.*". An empty pattern matches all job kinds.
+func (s *subscription) Kind() string {
+	return s.kind
+}
+
+// States returns the job states to notify. No states matches all job states.
+func (s *subscription) States() []JobState {
+	return s.states
+}
+
+// Secret returns the secret key used to sign the notification payloads, or an empty string if the payloads are not signed.
+func (s *subscription) Secret() string {
+	return s.secret
+}
+
+// CreatedAt returns the time when the subscription was created.
+func (s *subscription) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// Matches returns true if the subscription is notified of the state change of the job instance.
+func (s *subscription) Matches(state InstanceState) bool {
+	if 0 < len(s.kind) {
+		if ok, err := path.Match(s.kind, state.Kind()); err != nil || !ok {
+			return false
+		}
+	}
+	if len(s.states) == 0 {
+		return true
+	}
+	for _, js := range s.states {
+		if js.Is(state.State()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Map returns a map representation of the subscription. The secret is included only if it is set.
+func (s *subscription) Map() map[string]any {
+	states := make([]any, len(s.states))
+	for n, state := range s.states {
+		states[n] = state.String()
+	}
+	m := map[string]any{
+		idKey:        s.id.String(),
+		urlKey:       s.url,
+		kindKey:      s.kind,
+		statesKey:    states,
+		createdAtKey: NewTimestampFromTime(s.createdAt).String(),
+	}
+	if 0 < len(s.secret) {
+		m[secretKey] = s.secret
+	}
+	return m
+}
+
+// String returns the string representation of the subscription. The secret is not included.
+func (s *subscription) String() string {
+	str := s.id.String() + " " + s.url
+	if 0 < len(s.kind) {
+		str += " kind=" + s.kind
+	}
+	if 0 < len(s.states) {
+		states := make([]string, len(s.states))
+		for n, state := range s.states {
+			states[n] = state.String()
+		}
+		str += " states=" + strings.Join(states, ",")
+	}
+	return str
+}
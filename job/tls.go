@@ -0,0 +1,182 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSConfig is the interface for the TLS configuration of the job server and clients.
+type TLSConfig interface {
+	// SetTLSEnabled sets whether TLS is enabled.
+	SetTLSEnabled(enabled bool)
+	// IsTLSEnabled returns whether TLS is enabled.
+	IsTLSEnabled() bool
+	// SetTLSCertFile sets the path of the PEM-encoded certificate file.
+	SetTLSCertFile(file string)
+	// TLSCertFile returns the path of the PEM-encoded certificate file.
+	TLSCertFile() string
+	// SetTLSKeyFile sets the path of the PEM-encoded private key file.
+	SetTLSKeyFile(file string)
+	// TLSKeyFile returns the path of the PEM-encoded private key file.
+	TLSKeyFile() string
+	// SetTLSCAFile sets the path of the PEM-encoded CA certificate file to verify peer certificates.
+	SetTLSCAFile(file string)
+	// TLSCAFile returns the path of the PEM-encoded CA certificate file to verify peer certificates.
+	TLSCAFile() string
+	// SetTLSClientAuth sets whether the server requires and verifies client certificates (mutual TLS).
+	SetTLSClientAuth(enabled bool)
+	// TLSClientAuth returns whether the server requires and verifies client certificates (mutual TLS).
+	TLSClientAuth() bool
+}
+
+type tlsConfig struct {
+	enabled    bool
+	certFile   string
+	keyFile    string
+	caFile     string
+	clientAuth bool
+}
+
+// NewTLSConfig returns a new TLS configuration. TLS is disabled by default.
+func NewTLSConfig() TLSConfig {
+	return newTLSConfig()
+}
+
+func newTLSConfig() *tlsConfig {
+	return &tlsConfig{
+		enabled:    false,
+		certFile:   "",
+		keyFile:    "",
+		caFile:     "",
+		clientAuth: false,
+	}
+}
+
+// SetTLSEnabled sets whether TLS is enabled.
+func (config *tlsConfig) SetTLSEnabled(enabled bool) {
+	config.enabled = enabled
+}
+
+// IsTLSEnabled returns whether TLS is enabled.
+func (config *tlsConfig) IsTLSEnabled() bool {
+	return config.enabled
+}
+
+// SetTLSCertFile sets the path of the PEM-encoded certificate file.
+func (config *tlsConfig) SetTLSCertFile(file string) {
+	config.certFile = file
+}
+
+// TLSCertFile returns the path of the PEM-encoded certificate file.
+func (config *tlsConfig) TLSCertFile() string {
+	return config.certFile
+}
+
+// SetTLSKeyFile sets the path of the PEM-encoded private key file.
+func (config *tlsConfig) SetTLSKeyFile(file string) {
+	config.keyFile = file
+}
+
+// TLSKeyFile returns the path of the PEM-encoded private key file.
+func (config *tlsConfig) TLSKeyFile() string {
+	return config.keyFile
+}
+
+// SetTLSCAFile sets the path of the PEM-encoded CA certificate file to verify peer certificates.
+func (config *tlsConfig) SetTLSCAFile(file string) {
+	config.caFile = file
+}
+
+// TLSCAFile returns the path of the PEM-encoded CA certificate file to verify peer certificates.
+func (config *tlsConfig) TLSCAFile() string {
+	return config.caFile
+}
+
+// SetTLSClientAuth sets whether the server requires and verifies client certificates (mutual TLS).
+func (config *tlsConfig) SetTLSClientAuth(enabled bool) {
+	config.clientAuth = enabled
+}
+
+// TLSClientAuth returns whether the server requires and verifies client certificates (mutual TLS).
+func (config *tlsConfig) TLSClientAuth() bool {
+	return config.clientAuth
+}
+
+func newCertPoolFromFile(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w CA certificate file: %s", ErrInvalid, file)
+	}
+	return pool, nil
+}
+
+// newServerTLSConfig returns a new TLS configuration for the gRPC server.
+func newServerTLSConfig(config TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(config.TLSCertFile(), config.TLSKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{ // nolint:exhaustruct
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if config.TLSClientAuth() && len(config.TLSCAFile()) == 0 {
+		return nil, fmt.Errorf("client authentication requires a CA certificate file: %w", ErrInvalid)
+	}
+	// Client certificates are verified if given, and required if the client authentication is enabled.
+	if 0 < len(config.TLSCAFile()) {
+		pool, err := newCertPoolFromFile(config.TLSCAFile())
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if config.TLSClientAuth() {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return tlsConfig, nil
+}
+
+// newClientTLSConfig returns a new TLS configuration for gRPC clients.
+// The server certificate is verified with the CA certificate file if specified, otherwise with the system roots.
+func newClientTLSConfig(config TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ // nolint:exhaustruct
+		MinVersion: tls.VersionTLS12,
+	}
+	if 0 < len(config.TLSCAFile()) {
+		pool, err := newCertPoolFromFile(config.TLSCAFile())
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if 0 < len(config.TLSCertFile()) {
+		cert, err := tls.LoadX509KeyPair(config.TLSCertFile(), config.TLSKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
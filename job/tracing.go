@@ -0,0 +1,217 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// TracerName is the name of the OpenTelemetry tracer which records the spans of job instances.
+	TracerName = "github.com/cybergarage/go-job"
+)
+
+const (
+	// TraceSpanSchedule is the span name of a ScheduleJob gRPC call.
+	TraceSpanSchedule = "job.schedule"
+	// TraceSpanEnqueue is the span name of enqueuing a job instance.
+	TraceSpanEnqueue = "job.enqueue"
+	// TraceSpanQueueWait is the span name of the time a job instance waits in the queue until it is dequeued by a worker.
+	TraceSpanQueueWait = "job.queue_wait"
+	// TraceSpanExecute is the span name of executing a job instance.
+	TraceSpanExecute = "job.execute"
+	// TraceSpanStateChange is the span name of a state change of a job instance.
+	TraceSpanStateChange = "job.state_change"
+)
+
+const (
+	traceAttrKind    = attribute.Key("job.kind")
+	traceAttrUUID    = attribute.Key("job.uuid")
+	traceAttrState   = attribute.Key("job.state")
+	traceAttrAttempt = attribute.Key("job.attempt")
+)
+
+// WithTracerProvider sets the OpenTelemetry tracer provider used to trace job instances from scheduling to execution.
+// The exporter is configured on the tracer provider. By default, the global tracer provider is used.
+func WithTracerProvider(tp trace.TracerProvider) ManagerOption {
+	return func(m *manager) {
+		m.tracing.provider = tp
+	}
+}
+
+// WithTracePropagator sets the propagator which carries the trace context of job instances through the queue and the gRPC API.
+// By default, the W3C Trace Context propagator is used.
+func WithTracePropagator(propagator propagation.TextMapPropagator) ManagerOption {
+	return func(m *manager) {
+		m.tracing.propagator = propagator
+	}
+}
+
+// tracing records the spans of job instances. The trace context is stored in the job instances,
+// so that the spans of the workers dequeuing the instances from a shared store belong to the trace of the scheduler.
+// All methods are safe to call on a nil tracing.
+type tracing struct {
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+func newTracing() *tracing {
+	return &tracing{
+		provider:   nil,
+		propagator: propagation.TraceContext{},
+	}
+}
+
+func (t *tracing) tracer() trace.Tracer {
+	if t.provider == nil {
+		return otel.GetTracerProvider().Tracer(TracerName)
+	}
+	return t.provider.Tracer(TracerName)
+}
+
+// start starts a span of the job instance.
+func (t *tracing) start(ctx context.Context, name string, ji Instance, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if t == nil {
+		return ctx, noop.Span{}
+	}
+	opts = append(opts, trace.WithAttributes(
+		traceAttrKind.String(ji.Kind()),
+		traceAttrUUID.String(ji.UUID().String()),
+	))
+	return t.tracer().Start(ctx, name, opts...)
+}
+
+// end ends the span, and records the error if any.
+func (t *tracing) end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// inject stores the trace context of the specified context in the job instance.
+func (t *tracing) inject(ctx context.Context, ji *jobInstance) {
+	if t == nil {
+		return
+	}
+	carrier := propagation.MapCarrier{}
+	t.propagator.Inject(ctx, carrier)
+	if 0 < len(carrier) {
+		ji.traceContext = carrier
+	}
+}
+
+// extract returns a context with the trace context stored in the job instance.
+func (t *tracing) extract(ctx context.Context, ji *jobInstance) context.Context {
+	if t == nil || len(ji.traceContext) == 0 {
+		return ctx
+	}
+	return t.propagator.Extract(ctx, propagation.MapCarrier(ji.traceContext))
+}
+
+// extractGRPC returns a context with the trace context of the incoming gRPC metadata.
+func (t *tracing) extractGRPC(ctx context.Context) context.Context {
+	if t == nil {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{}
+	for key, values := range md {
+		if 0 < len(values) {
+			carrier[key] = values[0]
+		}
+	}
+	return t.propagator.Extract(ctx, carrier)
+}
+
+// queueWait records the span of the time the dequeued job instance waited in the queue.
+func (t *tracing) queueWait(ctx context.Context, ji *jobInstance) {
+	if t == nil || ji.enqueuedAt.IsZero() {
+		return
+	}
+	_, span := t.start(ctx, TraceSpanQueueWait, ji,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithTimestamp(ji.enqueuedAt),
+	)
+	span.End(trace.WithTimestamp(time.Now()))
+}
+
+// execute continues the trace of the dequeued job instance, and starts the span of executing the instance.
+func (t *tracing) execute(ctx context.Context, ji *jobInstance) (context.Context, trace.Span) {
+	if t == nil {
+		return ctx, noop.Span{}
+	}
+	ctx = t.extract(ctx, ji)
+	t.queueWait(ctx, ji)
+	return t.start(ctx, TraceSpanExecute, ji, trace.WithSpanKind(trace.SpanKindConsumer))
+}
+
+// endExecute ends the span of executing the job instance.
+func (t *tracing) endExecute(span trace.Span, ji *jobInstance, err error) {
+	span.SetAttributes(traceAttrAttempt.Int(ji.attempt))
+	t.end(span, err)
+}
+
+// stateChange records the span of the state change of the job instance in the trace of the instance.
+func (t *tracing) stateChange(ji *jobInstance, state JobState) {
+	if t == nil || len(ji.traceContext) == 0 {
+		return
+	}
+	ctx := ji.ctx
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = t.extract(context.Background(), ji)
+	}
+	_, span := t.start(ctx, TraceSpanStateChange, ji, trace.WithAttributes(
+		traceAttrState.String(state.String()),
+		traceAttrAttempt.Int(ji.attempt),
+	))
+	if state == JobTerminated || state == JobTimedOut {
+		t.end(span, ji.resultError)
+		return
+	}
+	span.End()
+}
+
+// newTraceContextFrom returns the trace context carrier from the specified value.
+func newTraceContextFrom(a any) (map[string]string, bool) {
+	switch v := a.(type) {
+	case map[string]string:
+		return v, true
+	case propagation.MapCarrier:
+		return v, true
+	case map[string]any:
+		carrier := map[string]string{}
+		for key, value := range v {
+			if s, ok := value.(string); ok {
+				carrier[key] = s
+			}
+		}
+		return carrier, true
+	}
+	return nil, false
+}
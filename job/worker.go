@@ -17,9 +17,15 @@ package job
 import (
 	"context"
 	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	logger "github.com/cybergarage/go-logger/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 // Worker is an interface that defines methods for processing jobs.
@@ -38,9 +44,18 @@ type Worker interface {
 	ProcessingInstance() (Instance, bool)
 }
 
+const (
+	// workerPauseInterval is the interval at which paused workers check whether they are resumed.
+	workerPauseInterval = 100 * time.Millisecond
+)
+
 type worker struct {
+	sync.Mutex
 	manager        Manager
+	metrics        *metrics
+	paused         *atomic.Bool
 	done           chan struct{}
+	busy           bool
 	processingInst Instance
 	jobCtx         context.Context
 	jobCancel      context.CancelFunc
@@ -54,12 +69,43 @@ func (w *worker) IsProcessing() bool {
 
 // ProcessingInstance returns the job instance being processed, if any.
 func (w *worker) ProcessingInstance() (Instance, bool) {
+	w.Lock()
+	defer w.Unlock()
 	if w.processingInst == nil {
 		return nil, false
 	}
 	return w.processingInst, true
 }
 
+// isBusy returns true if the worker has dequeued a job instance and is not finished with it.
+func (w *worker) isBusy() bool {
+	w.Lock()
+	defer w.Unlock()
+	return w.busy
+}
+
+// setBusy sets whether the worker has dequeued a job instance and is not finished with it.
+func (w *worker) setBusy(busy bool) {
+	w.Lock()
+	defer w.Unlock()
+	w.busy = busy
+}
+
+// isPaused returns true if the worker is paused from dequeuing job instances.
+func (w *worker) isPaused() bool {
+	return w.paused != nil && w.paused.Load()
+}
+
+// setProcessingInstance sets the job instance being processed with its context and cancel function.
+// The instance is cleared if it is nil.
+func (w *worker) setProcessingInstance(ji Instance, ctx context.Context, cancel context.CancelFunc) {
+	w.Lock()
+	defer w.Unlock()
+	w.processingInst = ji
+	w.jobCtx = ctx
+	w.jobCancel = cancel
+}
+
 // workerOption is a function that configures a job worker.
 type workerOption func(*worker)
 
@@ -69,11 +115,28 @@ func withWorkerManager(mgr Manager) workerOption {
 	}
 }
 
+func withWorkerMetrics(m *metrics) workerOption {
+	return func(w *worker) {
+		w.metrics = m
+	}
+}
+
+// withWorkerPaused sets the flag which pauses the worker from dequeuing job instances.
+func withWorkerPaused(paused *atomic.Bool) workerOption {
+	return func(w *worker) {
+		w.paused = paused
+	}
+}
+
 // newWorker creates a new instance of the job worker.
 func newWorker(opts ...workerOption) Worker {
 	w := &worker{
+		Mutex:          sync.Mutex{},
 		manager:        nil,
+		metrics:        nil,
+		paused:         nil,
 		done:           make(chan struct{}),
+		busy:           false,
 		processingInst: nil,
 		jobCtx:         nil,
 		jobCancel:      nil,
@@ -98,7 +161,11 @@ func (w *worker) Run() error {
 		logger.Error(err)
 		ji.Error(err)
 	}
-	retryInstance := func(ji Instance) {
+	retryInstance := func(ji Instance, cause error) {
+		w.metrics.retry(ji.Kind())
+		if jiImpl, ok := ji.(*jobInstance); ok {
+			jiImpl.hooks.retry(ji, cause)
+		}
 		backoffStrategy := ji.Policy().BackoffStrategy()
 		if backoffStrategy != nil {
 			backoff := backoffStrategy(ji)
@@ -121,76 +188,129 @@ func (w *worker) Run() error {
 		}
 	}
 
-	go func() {
-		for {
-			select {
-			case <-w.done:
-				w.processingInst = nil
-				return
-			default:
-				ji, err := w.manager.DequeueNextInstance()
-				if err != nil {
-					logger.Error(err)
-					continue
-				}
-				mQueuedJobs.WithLabelValues(ji.Kind()).Dec()
+	// processNextInstance dequeues and processes the next job instance. A panic in the hooks, the handlers or the state change processors
+	// is recovered, so that the panic terminates only the job instance and the worker keeps running.
+	processNextInstance := func() {
+		var ji Instance
+		defer func() {
+			if r := recover(); r != nil {
+				w.recoverPanic(ji, r)
+			}
+		}()
 
-				err = ji.UpdateState(JobProcessing)
-				if err != nil {
-					logError(ji, err)
-					continue
-				}
+		var err error
+		ji, err = w.manager.DequeueNextInstance()
+		if err != nil {
+			logger.Error(err)
+			return
+		}
+		// The worker is busy before checking the pause, so that the worker is not regarded as idle
+		// while processing the job instance which is dequeued before the worker is paused.
+		w.setBusy(true)
+		if w.isPaused() {
+			if err := w.manager.EnqueueInstance(ji); err != nil {
+				logError(ji, err)
+			}
+			w.setBusy(false)
+			return
+		}
+		jiImpl, isImpl := ji.(*jobInstance)
+		readyAt := time.Time{}
+		if isImpl {
+			readyAt = jiImpl.readyAt()
+		}
+		w.metrics.dequeue(ji.Kind(), readyAt)
 
-				w.jobCtx, w.jobCancel = context.WithCancel(context.Background())
-				timeout := ji.Policy().Timeout()
-				if 0 < timeout {
-					w.jobCtx, w.jobCancel = context.WithTimeout(w.jobCtx, timeout)
-				}
+		// Continue the trace of the job instance from the scheduler
+		traceCtx := context.Background()
+		var span trace.Span = noop.Span{}
+		if isImpl {
+			traceCtx, span = jiImpl.tracing.execute(traceCtx, jiImpl)
+			withContext(traceCtx)(jiImpl)
+		}
 
-				// Set internal options
-				if jiImpl, ok := ji.(*jobInstance); ok {
-					withContext(w.jobCtx)(jiImpl)
-				}
+		err = ji.UpdateState(JobProcessing)
+		if err != nil {
+			logError(ji, err)
+			span.End()
+			w.setBusy(false)
+			return
+		}
 
-				w.processingInst = ji
-				startedAt := time.Now()
-				mExecutedJobs.WithLabelValues(ji.Kind()).Inc()
-				res, err := ji.Process(w.jobCtx, w.jobCtx, w.manager, w, ji)
-				mJobDuration.WithLabelValues(ji.Kind()).Observe(time.Since(startedAt).Seconds())
+		var jobCtx context.Context
+		var jobCancel context.CancelFunc
+		timeout := ji.Policy().Timeout()
+		if 0 < timeout {
+			jobCtx, jobCancel = context.WithTimeout(traceCtx, timeout)
+		} else {
+			jobCtx, jobCancel = context.WithCancel(traceCtx)
+		}
 
-				if w.jobCancel != nil {
-					w.jobCancel()
-				}
-				w.jobCtx = nil
-				w.jobCancel = nil
+		// Set internal options
+		if isImpl {
+			withContext(jobCtx)(jiImpl)
+		}
 
-				if err == nil {
-					err = ji.UpdateState(JobCompleted, newResultWith(res))
-					if err != nil {
-						logError(ji, err)
-					}
-					ji.CompleteProcessor()(ji, res)
-					if ji.IsRecurring() {
-						rescheduleInstance(ji)
-					}
-				} else {
-					jobState := JobTerminated
-					if errors.Is(err, context.Canceled) {
-						jobState = JobCanceled
-					} else if errors.Is(err, context.DeadlineExceeded) {
-						jobState = JobTimedOut
-					}
-					err = ji.UpdateState(jobState, err)
-					if err != nil {
-						logError(ji, err)
-					}
-					if ji.IsRetriable() {
-						retryInstance(ji)
-					} else if ji.IsRecurring() {
-						rescheduleInstance(ji)
+		w.setProcessingInstance(ji, jobCtx, jobCancel)
+		startedAt := time.Now()
+		w.metrics.startProcessing(ji.Kind())
+		res, err := ji.Process(jobCtx, jobCtx, w.manager, w, ji)
+		w.metrics.endProcessing(ji.Kind(), startedAt)
+		if isImpl {
+			jiImpl.tracing.endExecute(span, jiImpl, err)
+		}
+
+		jobCancel()
+		w.setProcessingInstance(ji, nil, nil)
+
+		if err == nil {
+			err = ji.UpdateState(JobCompleted, newResultWith(res))
+			if err != nil {
+				logError(ji, err)
+			}
+			ji.HandleCompleted(ji, res)
+			if ji.IsRecurring() {
+				rescheduleInstance(ji)
+			}
+		} else {
+			jobState := JobTerminated
+			if errors.Is(err, context.Canceled) {
+				jobState = JobCanceled
+			} else if errors.Is(err, context.DeadlineExceeded) {
+				jobState = JobTimedOut
+			}
+			if updateErr := ji.UpdateState(jobState, err); updateErr != nil {
+				logError(ji, updateErr)
+			}
+			if ji.IsRetriable() {
+				retryInstance(ji, err)
+			} else if ji.IsRecurring() {
+				rescheduleInstance(ji)
+			}
+		}
+		w.setProcessingInstance(nil, nil, nil)
+		w.setBusy(false)
+	}
+
+	w.Lock()
+	done := w.done
+	w.Unlock()
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				w.setProcessingInstance(nil, nil, nil)
+				return
+			default:
+				if w.isPaused() {
+					select {
+					case <-done:
+					case <-time.After(workerPauseInterval):
 					}
+					continue
 				}
-				w.processingInst = nil
+				processNextInstance()
 			}
 		}
 	}()
@@ -198,9 +318,41 @@ func (w *worker) Run() error {
 	return nil
 }
 
+// recoverPanic converts the recovered panic in processing the job instance into an error which wraps ErrPanicked with the panic value
+// and the stack trace, and terminates the job instance unless it is already in a final state. The job instance is nil if the panic occurred in dequeuing.
+func (w *worker) recoverPanic(ji Instance, r any) {
+	defer func() {
+		w.setProcessingInstance(nil, nil, nil)
+		w.setBusy(false)
+	}()
+	err := fmt.Errorf("%w: %v\n%s", ErrPanicked, r, debug.Stack())
+	if ji == nil {
+		logger.Errorf("worker %s", err)
+		return
+	}
+	w.metrics.panic(ji.Kind())
+	logger.Errorf("job instance %s (%s) %s", ji.UUID(), ji.Kind(), err)
+	if err := w.Cancel(); err != nil && !errors.Is(err, ErrNotProcessing) {
+		logger.Error(err)
+	}
+	ji.Error(err)
+	if ji.State().Is(JobStateFinal) {
+		return
+	}
+	// The state change processors and hooks may panic again in terminating the job instance, whose state is recorded before them.
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("job instance %s (%s) %s: %v", ji.UUID(), ji.Kind(), ErrPanicked, r)
+		}
+	}()
+	if err := ji.UpdateState(JobTerminated, err); err != nil {
+		logger.Error(err)
+	}
+}
+
 // Wait waits for the worker to finish processing jobs.
 func (w *worker) Wait(ctx context.Context) error {
-	for w.IsProcessing() {
+	for w.isBusy() {
 		if deadline, ok := ctx.Deadline(); ok && !deadline.IsZero() {
 			select {
 			case <-ctx.Done():
@@ -216,7 +368,9 @@ func (w *worker) Wait(ctx context.Context) error {
 
 // Cancel cancels the currently processing job. Returns an error if no job is being processed.
 func (w *worker) Cancel() error {
-	if !w.IsProcessing() {
+	w.Lock()
+	defer w.Unlock()
+	if w.processingInst == nil {
 		return ErrNotProcessing
 	}
 	if w.jobCancel != nil {
@@ -235,6 +389,8 @@ func (w *worker) Stop() error {
 		// If not processing, just close the done channel
 		err = nil
 	}
+	w.Lock()
+	defer w.Unlock()
 	close(w.done)
 	w.done = make(chan struct{})
 	return err
@@ -18,11 +18,15 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
 	// DefaultWorkerNum is the default number of workers in the group.
 	DefaultWorkerNum = 1
+	// workerGroupWaitInterval is the interval at which the worker group checks whether all workers are idle.
+	workerGroupWaitInterval = 100 * time.Millisecond
 )
 
 // WorkerGroup is an interface that defines methods for managing a group of workers.
@@ -58,11 +62,21 @@ func withWorkerGroupManager(mgr Manager) WorkerGroupOption {
 	}
 }
 
+// withWorkerGroupMetrics sets the metrics of the manager measured by the workers.
+func withWorkerGroupMetrics(m *metrics) WorkerGroupOption {
+	return func(g *workerGroup) {
+		g.metrics = m
+	}
+}
+
 type workerGroup struct {
 	sync.Mutex
 
 	manager Manager
+	metrics *metrics
 	workers []Worker
+	running atomic.Bool
+	paused  atomic.Bool
 }
 
 func newWorkerGroup(opts ...WorkerGroupOption) *workerGroup {
@@ -70,6 +84,9 @@ func newWorkerGroup(opts ...WorkerGroupOption) *workerGroup {
 		Mutex:   sync.Mutex{},
 		workers: make([]Worker, DefaultWorkerNum),
 		manager: nil,
+		metrics: nil,
+		running: atomic.Bool{},
+		paused:  atomic.Bool{},
 	}
 	for _, opt := range opts {
 		opt(g)
@@ -89,20 +106,22 @@ func (g *workerGroup) Start() error {
 	if g.manager == nil {
 		return errors.New("worker group manager is not set")
 	}
+	g.paused.Store(false)
 	for i := 0; i < len(g.workers); i++ {
-		g.workers[i] = newWorker(withWorkerManager(g.manager))
+		g.workers[i] = g.newWorker()
 	}
 	for _, w := range g.workers {
 		if err := w.Start(); err != nil {
 			return errors.Join(err, g.Stop())
 		}
 	}
-	mWorkers.Set(float64(len(g.workers)))
+	g.running.Store(true)
 	return nil
 }
 
 // Stop stops all workers in the group.
 func (g *workerGroup) Stop() error {
+	g.running.Store(false)
 	for i := range len(g.workers) {
 		if err := g.workers[i].Stop(); err != nil {
 			return err
@@ -111,11 +130,45 @@ func (g *workerGroup) Stop() error {
 	return nil
 }
 
-// Wait waits for all workers in the group to finish processing.
+// isRunning returns true if the workers in the group are started and not stopped.
+func (g *workerGroup) isRunning() bool {
+	return g.running.Load()
+}
+
+// newWorker returns a new worker of the group.
+func (g *workerGroup) newWorker() Worker {
+	return newWorker(
+		withWorkerManager(g.manager),
+		withWorkerMetrics(g.metrics),
+		withWorkerPaused(&g.paused),
+	)
+}
+
+// pause pauses the workers in the group from dequeuing job instances until the group is started again.
+func (g *workerGroup) pause() {
+	g.paused.Store(true)
+}
+
+// isIdle returns true if no worker in the group is busy with a job instance.
+func (g *workerGroup) isIdle() bool {
+	for _, w := range g.Workers() {
+		if wi, ok := w.(*worker); ok && wi.isBusy() {
+			return false
+		}
+		if w.IsProcessing() {
+			return false
+		}
+	}
+	return true
+}
+
+// Wait waits until all workers in the group are idle at the same time, or the context is done.
 func (g *workerGroup) Wait(ctx context.Context) error {
-	for _, w := range g.workers {
-		if err := w.Wait(ctx); err != nil {
-			return err
+	for !g.isIdle() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(workerGroupWaitInterval):
 		}
 	}
 	return nil
@@ -137,7 +190,7 @@ func (g *workerGroup) ResizeWorkers(ctx context.Context, num int) error {
 
 	if len(g.workers) < num {
 		for i := len(g.workers); i < num; i++ {
-			worker := newWorker(withWorkerManager(g.manager))
+			worker := g.newWorker()
 			if err := worker.Start(); err != nil {
 				return err
 			}
@@ -155,7 +208,6 @@ func (g *workerGroup) ResizeWorkers(ctx context.Context, num int) error {
 		}
 		g.workers = g.workers[:num]
 	}
-	mWorkers.Set(float64(len(g.workers)))
 	return nil
 }
 
@@ -0,0 +1,289 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRBAC(t *testing.T) {
+	authz := job.NewRBAC(
+		job.NewAllowRule([]string{"admin"}, nil, nil),
+		job.NewAllowRule([]string{"reporter"}, []string{"ScheduleJob"}, []string{"report.*"}),
+		job.NewAllowRule([]string{"*"}, []string{"GetVersion", "Lookup*"}, nil),
+		job.NewDenyRule([]string{"*"}, []string{"CancelInstances"}, []string{"critical.*"}),
+	)
+
+	tests := []struct {
+		name    string
+		rpc     string
+		kind    string
+		allowed bool
+	}{
+		{"admin", "ScheduleJob", "any", true},
+		{"admin", "CancelInstances", "", true},
+		{"admin", "CancelInstances", "critical.backup", false},
+		{"reporter", "ScheduleJob", "report.daily", true},
+		{"reporter", "ScheduleJob", "cleanup", false},
+		{"reporter", "ScheduleJob", "", false},
+		{"reporter", "CancelInstances", "report.daily", false},
+		{"reporter", "GetVersion", "", true},
+		{"reporter", "LookupInstances", "report.daily", true},
+		{"guest", "ScheduleJob", "report.daily", false},
+	}
+
+	for _, test := range tests {
+		err := authz.Authorize(job.NewIdentity(test.name, job.AuthMethodToken), test.rpc, test.kind)
+		if test.allowed && err != nil {
+			t.Errorf("expected %s to be allowed to call %s (%s): %v", test.name, test.rpc, test.kind, err)
+		}
+		if !test.allowed && !errors.Is(err, job.ErrPermissionDenied) {
+			t.Errorf("expected %s to be denied to call %s (%s): %v", test.name, test.rpc, test.kind, err)
+		}
+	}
+}
+
+func TestServerAuth(t *testing.T) {
+	certs := newTestCertFiles(t, t.TempDir())
+
+	newServer := func(t *testing.T, opts ...any) job.Server {
+		t.Helper()
+		server, err := job.NewServer(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, kind := range []string{"report.daily", "cleanup"} {
+			j, err := job.NewJob(
+				job.WithKind(kind),
+				job.WithExecutor(func() {}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := server.Manager().RegisterJob(j); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return server
+	}
+
+	newClient := func(t *testing.T, server job.Server, opts ...func(job.Client)) job.Client {
+		t.Helper()
+		client := job.NewGrpcClient()
+		client.SetPort(server.GRPCPort())
+		for _, opt := range opts {
+			opt(client)
+		}
+		if err := client.Open(); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			client.Close()
+		})
+		return client
+	}
+
+	withToken := func(token string) func(job.Client) {
+		return func(client job.Client) {
+			client.SetAuthToken(token)
+		}
+	}
+
+	expectCode := func(t *testing.T, err error, code codes.Code) {
+		t.Helper()
+		if status.Code(err) != code {
+			t.Errorf("expected %s, got %v", code, err)
+		}
+	}
+
+	authz := job.NewRBAC(
+		job.NewAllowRule([]string{"admin", "jobctl"}, nil, nil),
+		job.NewAllowRule([]string{"reporter"}, []string{"GetVersion"}, nil),
+		job.NewAllowRule([]string{"reporter"}, []string{"ScheduleJob"}, []string{"report.*"}),
+		job.NewDenyRule([]string{"reporter"}, []string{"CancelInstances"}, nil),
+	)
+
+	t.Run("token", func(t *testing.T) {
+		server := newServer(t,
+			job.WithAuthenticators(job.NewTokenAuthenticator(map[string]string{
+				"admin-token":    "admin",
+				"reporter-token": "reporter",
+			})),
+			job.WithAuthorizer(authz),
+		)
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		// Callers without a valid token are unauthenticated.
+
+		_, err := newClient(t, server).GetVersion()
+		expectCode(t, err, codes.Unauthenticated)
+		_, err = newClient(t, server, withToken("invalid")).GetVersion()
+		expectCode(t, err, codes.Unauthenticated)
+
+		// Callers are authorized by the RBAC rules.
+
+		admin := newClient(t, server, withToken("admin-token"))
+		if _, err := admin.ScheduleJob("cleanup"); err != nil {
+			t.Error(err)
+		}
+		if _, err := admin.CancelInstances(job.NewQuery()); err != nil {
+			t.Error(err)
+		}
+
+		reporter := newClient(t, server, withToken("reporter-token"))
+		if _, err := reporter.GetVersion(); err != nil {
+			t.Error(err)
+		}
+		if _, err := reporter.ScheduleJob("report.daily"); err != nil {
+			t.Error(err)
+		}
+		_, err = reporter.ScheduleJob("cleanup")
+		expectCode(t, err, codes.PermissionDenied)
+		_, err = reporter.CancelInstances(job.NewQuery())
+		expectCode(t, err, codes.PermissionDenied)
+		_, err = reporter.ListRegisteredJobs()
+		expectCode(t, err, codes.PermissionDenied)
+
+		// Operations are audited with the authenticated identities.
+
+		records, err := admin.LookupAuditRecords(job.NewQuery(job.WithQueryKind("report.daily")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != 2 || records[1].Actor() != "reporter" || records[1].Operation() != job.AuditScheduleJob {
+			t.Errorf("unexpected audit records: %v", records)
+		}
+		_, err = reporter.LookupAuditRecords(job.NewQuery())
+		expectCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("kind scope", func(t *testing.T) {
+		server := newServer(t,
+			job.WithAuthenticators(job.NewTokenAuthenticator(map[string]string{
+				"canceller-token": "canceller",
+			})),
+			job.WithAuthorizer(job.NewRBAC(
+				job.NewAllowRule([]string{"canceller"}, []string{"CancelInstances"}, []string{"report.*"}),
+			)),
+		)
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		release := make(chan struct{})
+		blocking, err := job.NewJob(
+			job.WithKind("cleanup.blocking"),
+			job.WithExecutor(func() {
+				<-release
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ji, err := server.Manager().ScheduleJob(blocking)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for ji.State() != job.JobProcessing && ctx.Err() == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// Canceling the allowed kind does not cancel processing instances of other kinds.
+
+		canceller := newClient(t, server, withToken("canceller-token"))
+		canceled, err := canceller.CancelInstances(job.NewQuery(job.WithQueryKind("report.daily")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, canceledInstance := range canceled {
+			if canceledInstance.UUID() == ji.UUID() {
+				t.Errorf("expected %s not to be canceled", ji.UUID())
+			}
+		}
+		_, err = canceller.CancelInstances(job.NewQuery(job.WithQueryKind("cleanup.blocking")))
+		expectCode(t, err, codes.PermissionDenied)
+
+		close(release)
+		if _, err := server.Manager().WaitInstance(ctx, ji.UUID()); err != nil {
+			t.Errorf("expected %s to complete, got %v", ji.UUID(), err)
+		}
+	})
+
+	t.Run("mtls", func(t *testing.T) {
+		server := newServer(t,
+			job.WithAuthenticators(job.NewTLSAuthenticator()),
+			job.WithAuthorizer(authz),
+		)
+		server.SetTLSEnabled(true)
+		server.SetTLSCertFile(certs.serverCertFile)
+		server.SetTLSKeyFile(certs.serverKeyFile)
+		server.SetTLSCAFile(certs.caFile)
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		withTLS := func(clientCert bool) func(job.Client) {
+			return func(client job.Client) {
+				config := job.NewTLSConfig()
+				config.SetTLSEnabled(true)
+				config.SetTLSCAFile(certs.caFile)
+				if clientCert {
+					config.SetTLSCertFile(certs.clientCertFile)
+					config.SetTLSKeyFile(certs.clientKeyFile)
+				}
+				client.SetTLSConfig(config)
+			}
+		}
+
+		// The client certificate identity (jobctl) is allowed to call all RPCs.
+
+		client := newClient(t, server, withTLS(true))
+		if _, err := client.ScheduleJob("cleanup"); err != nil {
+			t.Error(err)
+		}
+
+		// Callers without a client certificate are unauthenticated.
+
+		_, err := newClient(t, server, withTLS(false)).GetVersion()
+		expectCode(t, err, codes.Unauthenticated)
+	})
+}
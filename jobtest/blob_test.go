@@ -0,0 +1,157 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/store"
+	"github.com/cybergarage/go-job/job/plugins/store/blob"
+	"github.com/cybergarage/go-job/jobtest/plugins/store/kv/memdb"
+)
+
+func TestClaimCheck(t *testing.T) {
+	const threshold = 64
+
+	stores := []job.Store{
+		job.NewLocalStore(),
+		store.NewKvStoreWith(memdb.NewStore()),
+	}
+
+	for _, store := range stores {
+		t.Run(store.Name(), func(t *testing.T) {
+			blobStore := blob.NewFileStore(t.TempDir())
+
+			mgr, err := job.NewManager(
+				job.WithStore(store),
+				job.WithBlobStore(blobStore),
+				job.WithClaimCheckThreshold(threshold),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mgr.Start(); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := mgr.Stop(); err != nil {
+					t.Error(err)
+				}
+			}()
+
+			type Input struct {
+				Prefix string `job:"prefix"`
+				Body   string `job:"body"`
+			}
+
+			claimJob, err := job.NewJob(
+				job.WithKind("claim check"),
+				job.WithExecutor(func(s string, in Input) string {
+					return in.Prefix + strings.ToUpper(s[:1]) + in.Body[:1]
+				}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			largeArg := strings.Repeat("a", threshold*2)
+			largeNamedArg := strings.Repeat("b", threshold*2)
+			ji, err := mgr.ScheduleJob(claimJob,
+				job.WithScheduleAfter(0),
+				job.WithArguments(largeArg),
+				job.WithNamedArguments(map[string]any{"prefix": "x", "body": largeNamedArg}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The large arguments are replaced with claim check references.
+
+			if arg, ok := ji.Arguments()[0].(string); ok && arg == largeArg {
+				t.Errorf("expected a claim check reference, got the argument")
+			}
+			if arg, ok := ji.NamedArguments()["body"].(string); ok && arg == largeNamedArg {
+				t.Errorf("expected a claim check reference, got the named argument")
+			}
+			if ji.NamedArguments()["prefix"] != "x" {
+				t.Errorf("expected the small named argument as is, got %v", ji.NamedArguments()["prefix"])
+			}
+			entries, err := os.ReadDir(blobStore.Dir())
+			if err != nil || len(entries) != 1 {
+				t.Errorf("expected blobs of one instance, got %v (%v)", entries, err)
+			}
+
+			// The offloaded arguments are fetched before execution.
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			rs, err := mgr.WaitInstance(ctx, ji.UUID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var res string
+			if err := rs.Scan(&res); err != nil || res != "xAb" {
+				t.Errorf("expected result xAb, got %v (%v)", rs, err)
+			}
+
+			// User arguments which look like legacy claim check references pass through unchanged.
+
+			mapJob, err := job.NewJob(
+				job.WithKind("claim check (user map)"),
+				job.WithExecutor(func(m map[string]any) string {
+					s, _ := m["claim_check"].(string)
+					return s
+				}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			userMap := map[string]any{"claim_check": "args-0"}
+			mji, err := mgr.ScheduleJob(mapJob,
+				job.WithScheduleAfter(0),
+				job.WithArguments(userMap),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(mji.Arguments()[0], userMap) {
+				t.Errorf("expected the user map as is, got %v", mji.Arguments()[0])
+			}
+			rs, err = mgr.WaitInstance(ctx, mji.UUID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rs.Scan(&res); err != nil || res != "args-0" {
+				t.Errorf("expected result args-0, got %v (%v)", rs, err)
+			}
+
+			// The blobs are deleted when the history is cleared.
+
+			if err := mgr.ClearInstanceHistory(job.NewFilter()); err != nil {
+				t.Fatal(err)
+			}
+			entries, err = os.ReadDir(blobStore.Dir())
+			if err != nil || len(entries) != 0 {
+				t.Errorf("expected no blobs, got %v (%v)", entries, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/job/command"
+)
+
+func TestCommandJob(t *testing.T) {
+	mgr, err := job.NewManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := mgr.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	tests := []struct {
+		name     string
+		command  []string
+		opts     []any
+		args     []any
+		expected string
+		code     int
+		fail     bool
+	}{
+		{
+			name:     "append arguments",
+			command:  []string{"echo", "hello"},
+			args:     []any{"go-job", 1},
+			expected: "hello go-job 1",
+		},
+		{
+			name:     "template",
+			command:  []string{"sh", "-c", "echo {{.Kind}} $(( {{index .Args 0}} + {{index .Args 1}} ))"},
+			args:     []any{1, 2},
+			expected: "template 3",
+		},
+		{
+			name:     "env and dir",
+			command:  []string{"sh", "-c", "echo $GREETING $(pwd)"},
+			opts:     []any{command.WithEnv("GREETING=hello"), command.WithDir("/")},
+			expected: "hello /",
+		},
+		{
+			name:    "exit code",
+			command: []string{"sh", "-c", "echo failed >&2; exit 3"},
+			code:    3,
+			fail:    true,
+		},
+		{
+			name:    "not found",
+			command: []string{"go-job-command-not-found"},
+			code:    -1,
+			fail:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j, err := command.NewCommandJob(tt.name, tt.command, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ji, err := mgr.ScheduleJob(j, job.WithArguments(tt.args...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			rs, err := mgr.WaitInstance(ctx, ji.UUID())
+			if tt.fail {
+				if err == nil {
+					t.Errorf("expected error, got %v", rs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var output string
+			var code int
+			if err := rs.Scan(&output, &code); err != nil {
+				t.Fatal(err)
+			}
+			if output != tt.expected || code != tt.code {
+				t.Errorf("expected (%q, %d), got (%q, %d)", tt.expected, tt.code, output, code)
+			}
+		})
+	}
+
+	t.Run("logs", func(t *testing.T) {
+		j, err := command.NewCommandJob("logs", []string{"sh", "-c", "echo out1; echo err1 >&2; printf 'out2'; exit 1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ji, err := mgr.ScheduleJob(j)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = mgr.WaitInstance(ctx, ji.UUID())
+		if err == nil || !strings.Contains(err.Error(), "err1") {
+			t.Errorf("expected error with the standard error, got %v", err)
+		}
+		logs, err := mgr.LookupInstanceLogs(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []struct {
+			level   job.LogLevel
+			message string
+		}{
+			{job.LogInfo, "out1"},
+			{job.LogInfo, "out2"},
+			{job.LogError, "err1"},
+		} {
+			found := slices.ContainsFunc(logs, func(log job.Log) bool {
+				return log.Level() == expected.level && log.Message() == expected.message
+			})
+			if !found {
+				t.Errorf("expected %s log %q, got %v", expected.level, expected.message, logs)
+			}
+		}
+	})
+
+	// The child processes of the command are killed together when the job instance is timed out or canceled.
+
+	t.Run("timeout", func(t *testing.T) {
+		j, err := command.NewCommandJob("sleep (timeout)", []string{"sh", "-c", "sleep 10; echo done"}, job.WithTimeout(100*time.Millisecond))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ji, err := mgr.ScheduleJob(j)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := mgr.WaitInstance(ctx, ji.UUID()); !errors.Is(err, job.ErrTimedOut) {
+			t.Errorf("expected %v, got %v", job.ErrTimedOut, err)
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		j, err := command.NewCommandJob("sleep (cancel)", []string{"sh", "-c", "sleep 10; echo done"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ji, err := mgr.ScheduleJob(j)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for ji.State() != job.JobProcessing && ctx.Err() == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if _, err := mgr.CancelInstances(job.NewQuery(job.WithQueryUUID(ji.UUID()))); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := mgr.WaitInstance(ctx, ji.UUID()); !errors.Is(err, job.ErrCanceled) {
+			t.Errorf("expected %v, got %v", job.ErrCanceled, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := command.NewCommandJob("empty", nil); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+		}
+		if _, err := command.NewCommandJob("template", []string{"echo", "{{.Args"}); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+		}
+	})
+}
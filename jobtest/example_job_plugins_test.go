@@ -62,3 +62,23 @@ func ExampleManager_scheduleRegisteredJob_systemLogCleaner() {
 	// Output:
 	// <nil>
 }
+
+func ExampleManager_scheduleRegisteredJob_systemAuditCleaner() {
+	// Create a job manager
+	mgr, _ := job.NewManager()
+
+	// Schedule the job with the manager
+	_, err := mgr.ScheduleJob(
+		system.NewAuditCleaner(),
+		job.WithCrontabSpec("0 0 * * *"),                    // Every day at midnight
+		job.WithArguments(time.Now().Add(-90*24*time.Hour)), // Delete audit records older than 90 days (Auto-injected arguments are automatically supplied when omitted)
+		job.WithJitter(func() time.Duration { // Add random jitter
+			return time.Duration(rand.Int63n(60)) * time.Second
+		}),
+	)
+
+	fmt.Println(err)
+
+	// Output:
+	// <nil>
+}
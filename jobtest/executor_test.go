@@ -189,3 +189,96 @@ func TestExecutor(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutorNamedArguments(t *testing.T) {
+	type sumParam struct {
+		A int
+		B int `job:"b"`
+	}
+
+	tests := []struct {
+		name      string
+		fn        any
+		params    []any
+		namedArgs job.NamedArguments
+		argNames  job.ArgumentNames
+		expected  []any
+	}{
+		{
+			name:      "names",
+			fn:        func(a int, b string) string { return fmt.Sprintf("%d%s", a, b) },
+			namedArgs: job.NamedArguments{"b": "x", "a": 1},
+			argNames:  job.ArgumentNames{"a", "b"},
+			expected:  []any{"1x"},
+		},
+		{
+			name:      "names (mixed)",
+			fn:        func(a int, b string) string { return fmt.Sprintf("%d%s", a, b) },
+			params:    []any{1},
+			namedArgs: job.NamedArguments{"b": "x"},
+			argNames:  job.ArgumentNames{"", "b"},
+			expected:  []any{"1x"},
+		},
+		{
+			name:      "names (context)",
+			fn:        func(ctx context.Context, a int, b int) int { return a - b },
+			namedArgs: job.NamedArguments{"b": 1, "a": 3},
+			argNames:  job.ArgumentNames{"a", "b"},
+			expected:  []any{2},
+		},
+		{
+			name:      "struct",
+			fn:        func(p sumParam) int { return p.A + p.B },
+			namedArgs: job.NamedArguments{"A": 1, "b": 2},
+			expected:  []any{3},
+		},
+		{
+			name:      "*struct",
+			fn:        func(p *sumParam) int { return p.A + p.B },
+			namedArgs: job.NamedArguments{"A": 1.0, "b": 2.0},
+			expected:  []any{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := job.Execute(tt.fn, tt.params, context.Background(), tt.namedArgs, tt.argNames)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual([]any(got), tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	errorTests := []struct {
+		name      string
+		fn        any
+		namedArgs job.NamedArguments
+		argNames  job.ArgumentNames
+	}{
+		{
+			name:      "unknown name",
+			fn:        func(a int) int { return a },
+			namedArgs: job.NamedArguments{"a": 1, "c": 2},
+			argNames:  job.ArgumentNames{"a"},
+		},
+		{
+			name:      "missing argument",
+			fn:        func(a int, b int) int { return a + b },
+			namedArgs: job.NamedArguments{"a": 1},
+			argNames:  job.ArgumentNames{"a", "b"},
+		},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := job.Execute(tt.fn, nil, context.Background(), tt.namedArgs, tt.argNames)
+			if err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
@@ -43,6 +43,13 @@ func TestFilter(t *testing.T) {
 			expectedBefore: false,
 			expectedAfter:  false,
 		},
+		{
+			opts: []job.FilterOption{
+				job.WithFilterAfter(time.Now()),
+			},
+			expectedBefore: false,
+			expectedAfter:  true,
+		},
 		{
 			opts: []job.FilterOption{
 				job.WithFilterBefore(time.Now()),
@@ -70,6 +77,9 @@ func TestFilter(t *testing.T) {
 			if tt.expectedAfter && after.IsZero() {
 				t.Errorf("expected After() to return a non-zero time, got zero time")
 			}
+			if query.IsUnset() != (!tt.expectedBefore && !tt.expectedAfter) {
+				t.Errorf("expected IsUnset() to return %t, got %t", !tt.expectedBefore && !tt.expectedAfter, query.IsUnset())
+			}
 		})
 	}
 }
@@ -0,0 +1,290 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/store"
+	"github.com/cybergarage/go-job/job/plugins/store/kv/redis"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestHealth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.Run("manager", func(t *testing.T) {
+		mgr, err := job.NewManager()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.Ready(ctx); !errors.Is(err, job.ErrNotReady) {
+			t.Errorf("expected %v before starting, got %v", job.ErrNotReady, err)
+		}
+		if err := mgr.Start(); err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.Ready(ctx); err != nil {
+			t.Error(err)
+		}
+		if err := mgr.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.Ready(ctx); !errors.Is(err, job.ErrNotReady) {
+			t.Errorf("expected %v after stopping, got %v", job.ErrNotReady, err)
+		}
+	})
+
+	t.Run("store", func(t *testing.T) {
+		option := redis.NewStoreOption()
+		option.Addr = net.JoinHostPort("localhost", strconv.Itoa(newTestFreePort(t)))
+		option.MaxRetries = -1
+		mgr, err := job.NewManager(job.WithStore(store.NewRedisStore(option)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.Start(); err == nil {
+			t.Error("expected an error of the unreachable store")
+		}
+		defer mgr.Stop()
+		if err := mgr.Ready(ctx); !errors.Is(err, job.ErrNotReady) {
+			t.Errorf("expected %v of the unreachable store, got %v", job.ErrNotReady, err)
+		}
+	})
+
+	t.Run("server", func(t *testing.T) {
+		server, err := job.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		server.SetGRPCPort(newTestFreePort(t))
+		server.SetPrometheusPort(newTestFreePort(t))
+		server.SetHTTPPort(newTestFreePort(t))
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer server.Stop()
+
+		conn, err := grpc.NewClient(
+			net.JoinHostPort("localhost", strconv.Itoa(server.GRPCPort())),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		client := healthpb.NewHealthClient(conn)
+
+		checkServing := func(t *testing.T, expected healthpb.HealthCheckResponse_ServingStatus) {
+			t.Helper()
+			for _, service := range []string{"", "job.v1.JobService"} {
+				res, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.GetStatus() != expected {
+					t.Errorf("service %q: expected %s, got %s", service, expected, res.GetStatus())
+				}
+			}
+		}
+
+		checkHTTP := func(t *testing.T, path string, expected int) {
+			t.Helper()
+			for _, port := range []int{server.PrometheusPort(), server.HTTPPort()} {
+				resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", port, path))
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if resp.StatusCode != expected {
+					t.Errorf("%s (%d): expected %d, got %d (%s)", path, port, expected, resp.StatusCode, b)
+				}
+			}
+		}
+
+		// The server is ready after starting.
+
+		if err := server.Ready(ctx); err != nil {
+			t.Error(err)
+		}
+		checkServing(t, healthpb.HealthCheckResponse_SERVING)
+		checkHTTP(t, "/healthz", http.StatusOK)
+		checkHTTP(t, "/readyz", http.StatusOK)
+
+		_, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected %s for an unknown service, got %v", codes.NotFound, err)
+		}
+
+		watch, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: ""})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := watch.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("expected %s, got %s", healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+		}
+
+		// The server is not ready while draining, and waits for the processing job instances.
+
+		release := make(chan struct{})
+		blocking, err := job.NewJob(
+			job.WithKind("blocking"),
+			job.WithExecutor(func() {
+				<-release
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ji, err := server.Manager().ScheduleJob(blocking)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for ji.State() != job.JobProcessing {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		drainCtx, drainCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer drainCancel()
+		if err := server.Drain(drainCtx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected %v while processing, got %v", context.DeadlineExceeded, err)
+		}
+		if !server.IsDraining() {
+			t.Error("expected the server to be draining")
+		}
+		if err := server.Ready(ctx); !errors.Is(err, job.ErrDraining) {
+			t.Errorf("expected %v, got %v", job.ErrDraining, err)
+		}
+		checkServing(t, healthpb.HealthCheckResponse_NOT_SERVING)
+		checkHTTP(t, "/healthz", http.StatusOK)
+		checkHTTP(t, "/readyz", http.StatusServiceUnavailable)
+
+		res, err = watch.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("expected %s, got %s", healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+		}
+
+		close(release)
+		if _, err := server.Manager().WaitInstance(ctx, ji.UUID()); err != nil {
+			t.Fatal(err)
+		}
+		if err := server.Drain(ctx); err != nil {
+			t.Error(err)
+		}
+
+		// The server is ready again after restarting.
+
+		if err := server.Restart(); err != nil {
+			t.Fatal(err)
+		}
+		if server.IsDraining() {
+			t.Error("expected the server not to be draining after restarting")
+		}
+		if err := server.Ready(ctx); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("drain", func(t *testing.T) {
+		const numWorkers = 2
+		const numInstances = 6
+		server, err := job.NewServer(job.WithNumWorkers(numWorkers))
+		if err != nil {
+			t.Fatal(err)
+		}
+		server.SetGRPCPort(newTestFreePort(t))
+		server.SetPrometheusPort(newTestFreePort(t))
+		server.SetHTTPPort(newTestFreePort(t))
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer server.Stop()
+
+		// The queue holds more job instances than the workers.
+
+		mgr := server.Manager()
+		sleeping, err := job.NewJob(
+			job.WithKind("sleeping"),
+			job.WithExecutor(func() {
+				time.Sleep(200 * time.Millisecond)
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range numInstances {
+			if _, err := mgr.ScheduleJob(sleeping); err != nil {
+				t.Fatal(err)
+			}
+		}
+		isProcessing := func() bool {
+			for _, w := range mgr.Workers() {
+				if w.IsProcessing() {
+					return true
+				}
+			}
+			return false
+		}
+		for !isProcessing() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// The workers stop dequeuing, and the server is drained when all workers are idle.
+
+		if err := server.Drain(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if isProcessing() {
+			t.Error("expected no processing worker after draining")
+		}
+		queued, err := mgr.Store().ListInstances(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(queued) == 0 || numInstances-numWorkers < len(queued) {
+			t.Errorf("expected %d queued instances at most except the processed instances, got %d", numInstances-numWorkers, len(queued))
+		}
+		time.Sleep(500 * time.Millisecond)
+		remaining, err := mgr.Store().ListInstances(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(remaining) != len(queued) || isProcessing() {
+			t.Errorf("expected %d queued instances after draining, got %d", len(queued), len(remaining))
+		}
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+type hookTenantKey struct{}
+
+func TestManagerHooks(t *testing.T) {
+	var mutex sync.Mutex
+	events := []string{}
+	record := func(format string, args ...any) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		events = append(events, fmt.Sprintf(format, args...))
+	}
+
+	errFirstAttempt := errors.New("first attempt failed")
+
+	mgr, err := job.NewManager(
+		job.WithOnSchedule(func(ji job.Instance) {
+			record("schedule")
+		}),
+		job.WithOnDequeue(func(ji job.Instance) {
+			record("dequeue")
+		}),
+		job.WithBeforeExecute(func(ctx context.Context, ji job.Instance) (context.Context, error) {
+			record("before")
+			return context.WithValue(ctx, hookTenantKey{}, "acme"), nil
+		}),
+		job.WithAfterExecute(func(ctx context.Context, ji job.Instance, res []any, err error) {
+			record("after(%v)", err)
+		}),
+		job.WithOnStateChange(func(ji job.Instance, state job.JobState) {
+			record("%s", state)
+		}),
+		job.WithOnRetry(func(ji job.Instance, err error) {
+			record("retry(%v)", err)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := job.NewJob(
+		job.WithKind("tenant"),
+		job.WithExecutor(func(ctx context.Context, ji job.Instance) (string, error) {
+			if ji.Attempts() == 1 {
+				return "", errFirstAttempt
+			}
+			tenant, _ := ctx.Value(hookTenantKey{}).(string)
+			return tenant, nil
+		}),
+		job.WithFailOnErrorResult(),
+		job.WithMaxRetries(2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ji, err := mgr.ScheduleJob(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rs, err := mgr.WaitInstance(ctx, ji.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tenant string
+	if err := rs.Scan(&tenant); err != nil || tenant != "acme" {
+		t.Errorf("expected the executor to receive the hook context value, got %q (%v)", tenant, err)
+	}
+
+	expected := []string{
+		"Created",
+		"Scheduled",
+		"schedule",
+		"dequeue",
+		"Processing",
+		"before",
+		fmt.Sprintf("after(%v)", errFirstAttempt),
+		"Terminated",
+		fmt.Sprintf("retry(%v)", errFirstAttempt),
+		"Scheduled",
+		"dequeue",
+		"Processing",
+		"before",
+		"after(<nil>)",
+		"Completed",
+	}
+	// The state change hooks are called after the state is recorded in the history.
+	for {
+		mutex.Lock()
+		n := len(events)
+		mutex.Unlock()
+		if len(expected) <= n || ctx.Err() != nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if strings.Join(events, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected hook events %v, got %v", expected, events)
+	}
+}
+
+func TestManagerBeforeExecuteHookError(t *testing.T) {
+	errRejected := errors.New("rejected by hook")
+	executed := false
+
+	mgr, err := job.NewManager(
+		job.WithBeforeExecute(func(ctx context.Context, ji job.Instance) (context.Context, error) {
+			return ctx, errRejected
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := job.NewJob(
+		job.WithKind("rejected"),
+		job.WithExecutor(func() { executed = true }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ji, err := mgr.ScheduleJob(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = mgr.WaitInstance(ctx, ji.UUID())
+	if err == nil || !strings.Contains(err.Error(), errRejected.Error()) {
+		t.Errorf("expected %v, got %v", errRejected, err)
+	}
+	if executed {
+		t.Errorf("expected the executor not to run")
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+func newTestFreePort(t *testing.T) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func httpRequestJSON(t *testing.T, method string, url string, token string, body string) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 0 < len(token) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := map[string]any{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("invalid JSON response (%s): %v", string(b), err)
+	}
+	return resp.StatusCode, m
+}
+
+func HTTPGatewayTest(t *testing.T, baseURL string) {
+	t.Helper()
+
+	// Get the version
+
+	code, res := httpRequestJSON(t, http.MethodGet, baseURL+"/v1/version", "", "")
+	if code != http.StatusOK || res["version"] != job.Version || res["api_version"] != job.DefaultAPIVersion {
+		t.Errorf("unexpected version response: %d %v", code, res)
+	}
+
+	// List the registered jobs
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/jobs", "", "")
+	if jobs, ok := res["jobs"].([]any); code != http.StatusOK || !ok || len(jobs) != 1 {
+		t.Errorf("unexpected jobs response: %d %v", code, res)
+	}
+
+	// Watch the job instances
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/v1/instances/watch?kind=sum", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watchResp.Body.Close()
+	if ct := watchResp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected watch content type: %s", ct)
+	}
+
+	// Schedule a job
+
+	code, res = httpRequestJSON(t, http.MethodPost, baseURL+"/v1/instances", "", `{"kind":"sum","arguments":["1","2"]}`)
+	if code != http.StatusOK {
+		t.Fatalf("failed to schedule job: %d %v", code, res)
+	}
+	instance, _ := res["instance"].(map[string]any)
+	uuid, _ := instance["uuid"].(string)
+	if len(uuid) == 0 {
+		t.Fatalf("unexpected schedule response: %v", res)
+	}
+
+	// Receive the state changes of the job instance until it completes
+
+	states := []string{}
+	scanner := bufio.NewScanner(watchResp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		m := map[string]any{}
+		if err := json.Unmarshal([]byte(data), &m); err != nil {
+			t.Fatalf("invalid watch event (%s): %v", data, err)
+		}
+		if m["uuid"] != uuid {
+			continue
+		}
+		state, _ := m["state"].(string)
+		states = append(states, state)
+		if state == "JOB_STATE_COMPLETED" {
+			break
+		}
+	}
+	if len(states) == 0 || states[len(states)-1] != "JOB_STATE_COMPLETED" {
+		t.Errorf("unexpected watched states: %v", states)
+	}
+
+	// Wait for the job instance result
+
+	code, res = httpRequestJSON(t, http.MethodGet, fmt.Sprintf("%s/v1/instances/%s/wait", baseURL, uuid), "", "")
+	instance, _ = res["instance"].(map[string]any)
+	if code != http.StatusOK || instance["state"] != "JOB_STATE_COMPLETED" {
+		t.Errorf("unexpected wait response: %d %v", code, res)
+	}
+	if results, ok := instance["results"].([]any); !ok || len(results) != 1 || results[0] != "3" {
+		t.Errorf("unexpected results: %v", instance["results"])
+	}
+
+	// Wait for an unknown job instance
+
+	code, res = httpRequestJSON(t, http.MethodGet, fmt.Sprintf("%s/v1/instances/%s/wait", baseURL, job.NewUUID()), "", "")
+	if code != http.StatusNotFound {
+		t.Errorf("unexpected wait response: %d %v", code, res)
+	}
+
+	// Lookup the job instance
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/instances?uuid="+uuid+"&state=JOB_STATE_COMPLETED", "", "")
+	if instances, ok := res["instances"].([]any); code != http.StatusOK || !ok || len(instances) != 1 {
+		t.Errorf("unexpected lookup response: %d %v", code, res)
+	}
+
+	// Lookup the job instances by pages
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/instances?kind=sum&limit=1&order=desc", "", "")
+	token, _ := res["next_page_token"].(string)
+	if instances, ok := res["instances"].([]any); code != http.StatusOK || !ok || len(instances) != 1 || len(token) == 0 {
+		t.Errorf("unexpected page response: %d %v", code, res)
+	}
+	for _, query := range []string{"limit=-1", "offset=x", "order=random", "page_token=invalid"} {
+		code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/instances?"+query, "", "")
+		if code != http.StatusBadRequest {
+			t.Errorf("%s: unexpected page error response: %d %v", query, code, res)
+		}
+	}
+
+	// Cancel the job instances
+
+	code, res = httpRequestJSON(t, http.MethodPost, baseURL+"/v1/instances/cancel", "", `{"query":{"kind":"sum"}}`)
+	if code != http.StatusOK {
+		t.Errorf("failed to cancel job instances: %d %v", code, res)
+	}
+
+	// Lookup the audit records
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/audit?uuid="+uuid, "", "")
+	if records, ok := res["records"].([]any); code != http.StatusOK || !ok || len(records) != 1 {
+		t.Errorf("unexpected audit response: %d %v", code, res)
+	}
+
+	// Get the queue statistics
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/stats?window=10m", "", "")
+	stats, _ := res["stats"].(map[string]any)
+	if total, ok := stats["total"].(map[string]any); code != http.StatusOK || !ok || stats["window"] != "600s" || total["completed"] == nil {
+		t.Errorf("unexpected stats response: %d %v", code, res)
+	}
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/stats?window=-1m", "", "")
+	if code != http.StatusBadRequest {
+		t.Errorf("unexpected stats error response: %d %v", code, res)
+	}
+
+	// Invalid requests are returned as the JSON representation of the gRPC status
+
+	code, res = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/instances?state=unknown", "", "")
+	if code != http.StatusBadRequest || res["message"] == nil {
+		t.Errorf("unexpected error response: %d %v", code, res)
+	}
+	code, res = httpRequestJSON(t, http.MethodPost, baseURL+"/v1/instances", "", `{"kind":`)
+	if code != http.StatusBadRequest || res["message"] == nil {
+		t.Errorf("unexpected error response: %d %v", code, res)
+	}
+
+	// Show the dashboard pages
+
+	dashboardTests := []struct {
+		path     string
+		code     int
+		contains []string
+	}{
+		{"/dashboard/", http.StatusOK, []string{"Workers", "sum", uuid}},
+		{"/dashboard/?kind=sum&state=Completed", http.StatusOK, []string{uuid}},
+		{"/dashboard/instances/" + uuid, http.StatusOK, []string{uuid, "Completed", "State History"}},
+		{"/dashboard/?state=unknown", http.StatusBadRequest, nil},
+		{"/dashboard/instances/invalid", http.StatusBadRequest, nil},
+	}
+	for _, test := range dashboardTests {
+		resp, err := http.Get(baseURL + test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != test.code {
+			t.Errorf("%s: expected %d, got %d", test.path, test.code, resp.StatusCode)
+		}
+		for _, s := range test.contains {
+			if !bytes.Contains(b, []byte(s)) {
+				t.Errorf("%s: expected %q in the page", test.path, s)
+			}
+		}
+	}
+}
+
+func TestServerHTTPGateway(t *testing.T) {
+	newServer := func(t *testing.T, opts ...any) job.Server {
+		t.Helper()
+		server, err := job.NewServer(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		j, err := job.NewJob(
+			job.WithKind("sum"),
+			job.WithExecutor(func(a, b int) int { return a + b }),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := server.Manager().RegisterJob(j); err != nil {
+			t.Fatal(err)
+		}
+		return server
+	}
+
+	startServer := func(t *testing.T, server job.Server) {
+		t.Helper()
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	t.Run("port", func(t *testing.T) {
+		server := newServer(t)
+		server.SetHTTPPort(newTestFreePort(t))
+		startServer(t, server)
+		HTTPGatewayTest(t, fmt.Sprintf("http://localhost:%d", server.HTTPPort()))
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		server := newServer(t)
+		server.SetHTTPPort(server.PrometheusPort())
+		startServer(t, server)
+		baseURL := fmt.Sprintf("http://localhost:%d", server.PrometheusPort())
+		HTTPGatewayTest(t, baseURL)
+
+		resp, err := http.Get(baseURL + "/metrics")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK || !bytes.Contains(b, []byte("go_job_")) {
+			t.Errorf("unexpected metrics response: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		server := newServer(t,
+			job.WithAuthenticators(job.NewTokenAuthenticator(map[string]string{
+				"admin-token":  "admin",
+				"viewer-token": "viewer",
+			})),
+			job.WithAuthorizer(job.NewRBAC(
+				job.NewAllowRule([]string{"admin"}, nil, nil),
+				job.NewAllowRule([]string{"viewer"}, []string{"GetVersion", "LookupInstances"}, nil),
+			)),
+		)
+		server.SetHTTPPort(newTestFreePort(t))
+		startServer(t, server)
+		baseURL := fmt.Sprintf("http://localhost:%d", server.HTTPPort())
+
+		code, _ := httpRequestJSON(t, http.MethodGet, baseURL+"/v1/version", "", "")
+		if code != http.StatusUnauthorized {
+			t.Errorf("expected %d, got %d", http.StatusUnauthorized, code)
+		}
+		code, _ = httpRequestJSON(t, http.MethodGet, baseURL+"/v1/version", "viewer-token", "")
+		if code != http.StatusOK {
+			t.Errorf("expected %d, got %d", http.StatusOK, code)
+		}
+		resp, err := http.Get(baseURL + "/dashboard/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+		code, _ = httpRequestJSON(t, http.MethodPost, baseURL+"/v1/instances", "viewer-token", `{"kind":"sum","arguments":["1","2"]}`)
+		if code != http.StatusForbidden {
+			t.Errorf("expected %d, got %d", http.StatusForbidden, code)
+		}
+		code, res := httpRequestJSON(t, http.MethodPost, baseURL+"/v1/instances", "admin-token", `{"kind":"sum","arguments":["1","2"]}`)
+		if code != http.StatusOK {
+			t.Errorf("expected %d, got %d", http.StatusOK, code)
+		}
+
+		// Operations through the gateway are audited with the authenticated identities.
+
+		instance, _ := res["instance"].(map[string]any)
+		uuid, _ := instance["uuid"].(string)
+		records, err := server.Manager().LookupAuditRecords(job.NewQuery())
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, record := range records {
+			if record.Actor() == "admin" && 0 < len(record.UUIDs()) && record.UUIDs()[0].String() == uuid {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected audit record of %s by admin, got %v", uuid, records)
+		}
+	})
+}
@@ -16,8 +16,11 @@ package jobtest
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -546,10 +549,676 @@ func ManagerJobCancelTest(t *testing.T, mgr job.Manager) {
 	}
 }
 
+func ManagerJobNamedArgumentsTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	kind := "concat (named)"
+
+	var doneProcessing sync.WaitGroup
+	doneProcessing.Add(1)
+
+	var result []any
+	j, err := job.NewJob(
+		job.WithKind(kind),
+		job.WithExecutor(func(b string, ctx context.Context, a int) string {
+			return fmt.Sprintf("%d:%s", a, b)
+		}),
+		job.WithArgumentNames("b", "a"),
+		job.WithCompleteProcessor(func(ji job.Instance, res []any) {
+			result = res
+			doneProcessing.Done()
+		}),
+		job.WithTerminateProcessor(func(ji job.Instance, err error) error {
+			t.Errorf("Error in job execution: %s (%s) %s", ji.Kind(), ji.UUID(), err)
+			doneProcessing.Done()
+			return err
+		}),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+
+	ji, err := mgr.ScheduleJob(
+		j,
+		job.WithScheduleAfter(0), // immediate scheduling
+		job.WithNamedArguments(map[string]any{"a": 1, "b": "x"}),
+	)
+	if err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	doneProcessing.Wait()
+
+	if len(result) != 1 || result[0] != "1:x" {
+		t.Errorf("Expected result [1:x], but got %v", result)
+	}
+
+	instances, err := mgr.LookupInstances(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+	if err != nil {
+		t.Errorf("Failed to lookup job instance: %v", err)
+		return
+	}
+	if len(instances) != 1 {
+		t.Errorf("Expected exactly one job instance, but got %d", len(instances))
+		return
+	}
+	namedArgs := instances[0].NamedArguments()
+	if len(namedArgs) != 2 {
+		t.Errorf("Expected 2 named arguments, but got %v", namedArgs)
+	}
+}
+
+func ManagerJobResultSetTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	type summary struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	kind := "summary (result set)"
+
+	var doneProcessing sync.WaitGroup
+	doneProcessing.Add(1)
+
+	j, err := job.NewJob(
+		job.WithKind(kind),
+		job.WithExecutor(func(name string, count int) (summary, int) {
+			return summary{Name: name, Count: count}, count * 2
+		}),
+		job.WithCompleteProcessor(func(ji job.Instance, res []any) {
+			doneProcessing.Done()
+		}),
+		job.WithTerminateProcessor(func(ji job.Instance, err error) error {
+			t.Errorf("Error in job execution: %s (%s) %s", ji.Kind(), ji.UUID(), err)
+			doneProcessing.Done()
+			return err
+		}),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+
+	ji, err := mgr.ScheduleJob(
+		j,
+		job.WithScheduleAfter(0), // immediate scheduling
+		job.WithArguments("go-job", 21),
+	)
+	if err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	doneProcessing.Wait()
+
+	// Lookup job instance (from history) and scan the typed results
+
+	instances, err := mgr.LookupInstances(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+	if err != nil {
+		t.Errorf("Failed to lookup job instance: %v", err)
+		return
+	}
+	if len(instances) != 1 {
+		t.Errorf("Expected exactly one job instance, but got %d", len(instances))
+		return
+	}
+	rs, err := instances[0].ResultSet()
+	if err != nil {
+		t.Errorf("Expected job instance to have a result set, but got error: %v", err)
+		return
+	}
+
+	var s summary
+	var n int
+	if err := rs.Scan(&s, &n); err != nil {
+		t.Errorf("Failed to scan result set %v: %v", rs, err)
+		return
+	}
+	if s.Name != "go-job" || s.Count != 21 || n != 42 {
+		t.Errorf("Expected results [{go-job 21} 42], but got [%v %v]", s, n)
+	}
+}
+
+func ManagerJobWaitTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	sumJob, err := job.NewJob(
+		job.WithKind("sum (wait)"),
+		job.WithExecutor(func(a, b int) int { return a + b }),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+
+	errJob, err := job.NewJob(
+		job.WithKind("error (wait)"),
+		job.WithExecutor(func(a int) int { return a }),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Wait for a completed job instance by the manager and the instance
+
+	ji, err := mgr.ScheduleJob(sumJob, job.WithScheduleAfter(0), job.WithArguments(1, 2))
+	if err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	rs, err := mgr.WaitInstance(ctx, ji.UUID())
+	if err != nil {
+		t.Errorf("Failed to wait job instance: %v", err)
+		return
+	}
+	var sum int
+	if err := rs.Scan(&sum); err != nil || sum != 3 {
+		t.Errorf("Expected result 3, but got %v (%v)", rs, err)
+	}
+
+	rs, err = ji.Await(ctx)
+	if err != nil {
+		t.Errorf("Failed to await job instance: %v", err)
+		return
+	}
+	if err := rs.Scan(&sum); err != nil || sum != 3 {
+		t.Errorf("Expected result 3, but got %v (%v)", rs, err)
+	}
+
+	// Wait for a terminated job instance
+
+	ji, err = mgr.ScheduleJob(errJob, job.WithScheduleAfter(0), job.WithArguments("not a number"))
+	if err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	_, err = ji.Await(ctx)
+	if err == nil {
+		t.Errorf("Expected job error, but got nil")
+	}
+
+	// Wait for an unknown job instance without a deadline
+
+	_, err = mgr.WaitInstance(context.Background(), job.NewUUID())
+	if !errors.Is(err, job.ErrNotFound) {
+		t.Errorf("Expected %v, but got %v", job.ErrNotFound, err)
+	}
+}
+
+func ManagerJobAuditTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	if err := mgr.ClearAuditRecords(job.NewFilter()); err != nil {
+		t.Errorf("Failed to clear audit records: %v", err)
+		return
+	}
+
+	auditJob, err := job.NewJob(
+		job.WithKind("audit"),
+		job.WithExecutor(func() {}),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+
+	if err := mgr.RegisterJob(auditJob); err != nil {
+		t.Errorf("Failed to register job: %v", err)
+		return
+	}
+
+	ji, err := mgr.ScheduleRegisteredJob(auditJob.Kind(), job.WithScheduleAt(time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	if _, err := mgr.CancelInstances(job.NewQuery(job.WithQueryUUID(ji.UUID()))); err != nil {
+		t.Errorf("Failed to cancel job instances: %v", err)
+		return
+	}
+
+	if err := mgr.ClearInstanceHistory(job.NewFilter(job.WithFilterBefore(time.Now().Add(-time.Hour)))); err != nil {
+		t.Errorf("Failed to clear job history: %v", err)
+		return
+	}
+
+	// Check the audit records in the order of operations
+
+	records, err := mgr.LookupAuditRecords(job.NewQuery())
+	if err != nil {
+		t.Errorf("Failed to lookup audit records: %v", err)
+		return
+	}
+
+	expected := []job.AuditOperation{
+		job.AuditRegisterJob,
+		job.AuditScheduleJob,
+		job.AuditCancelInstances,
+		job.AuditClearHistory,
+	}
+	if len(records) != len(expected) {
+		t.Errorf("Expected %d audit records, but got %d (%v)", len(expected), len(records), records)
+		return
+	}
+	for n, record := range records {
+		if record.Operation() != expected[n] {
+			t.Errorf("Expected operation %s, but got %s", expected[n], record.Operation())
+		}
+		if record.Actor() != job.DefaultAuditActor {
+			t.Errorf("Expected actor %s, but got %s", job.DefaultAuditActor, record.Actor())
+		}
+	}
+	if records[1].Kind() != auditJob.Kind() {
+		t.Errorf("Expected kind %s, but got %s", auditJob.Kind(), records[1].Kind())
+	}
+	for _, record := range records[1:3] {
+		if uuids := record.UUIDs(); len(uuids) != 1 || uuids[0] != ji.UUID() {
+			t.Errorf("Expected UUIDs [%s], but got %v", ji.UUID(), uuids)
+		}
+	}
+
+	// Lookup the audit records by the instance UUID
+
+	records, err = mgr.LookupAuditRecords(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+	if err != nil {
+		t.Errorf("Failed to lookup audit records: %v", err)
+		return
+	}
+	if len(records) != 2 {
+		t.Errorf("Expected 2 audit records, but got %d (%v)", len(records), records)
+	}
+
+	// Clear the audit records
+
+	if err := mgr.ClearAuditRecords(job.NewFilter(job.WithFilterBefore(time.Now().Add(time.Second)))); err != nil {
+		t.Errorf("Failed to clear audit records: %v", err)
+		return
+	}
+	records, err = mgr.LookupAuditRecords(job.NewQuery())
+	if err != nil {
+		t.Errorf("Failed to lookup audit records: %v", err)
+		return
+	}
+	if len(records) != 0 {
+		t.Errorf("Expected no audit records, but got %d (%v)", len(records), records)
+	}
+}
+
+func ManagerSubscriptionTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	urls := []string{
+		"https://example.com/hooks/billing",
+		"https://example.com/hooks/all",
+	}
+
+	billingSub, err := job.NewSubscription(
+		job.WithSubscriptionURL(urls[0]),
+		job.WithSubscriptionKind("billing.*"),
+		job.WithSubscriptionStates(job.JobTerminated),
+		job.WithSubscriptionSecret("s3cr3t"),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+	allSub, err := job.NewSubscription(
+		job.WithSubscriptionURL(urls[1]),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+
+	for _, sub := range []job.Subscription{billingSub, allSub} {
+		if err := mgr.AddSubscription(sub); err != nil {
+			t.Fatalf("Failed to add subscription: %v", err)
+		}
+	}
+
+	subs, err := mgr.ListSubscriptions()
+	if err != nil {
+		t.Fatalf("Failed to list subscriptions: %v", err)
+	}
+	if len(subs) != 2 {
+		t.Fatalf("Expected 2 subscriptions, but got %d (%v)", len(subs), subs)
+	}
+	for n, sub := range subs {
+		if sub.URL() != urls[n] {
+			t.Errorf("Expected subscription URL %s, but got %s", urls[n], sub.URL())
+		}
+	}
+	if subs[0].ID() != billingSub.ID() || subs[0].Kind() != "billing.*" || subs[0].Secret() != "s3cr3t" {
+		t.Errorf("Expected subscription %v, but got %v", billingSub, subs[0])
+	}
+	if len(subs[0].States()) != 1 || subs[0].States()[0] != job.JobTerminated {
+		t.Errorf("Expected subscription states %v, but got %v", billingSub.States(), subs[0].States())
+	}
+
+	if err := mgr.RemoveSubscription(billingSub.ID()); err != nil {
+		t.Fatalf("Failed to remove subscription: %v", err)
+	}
+	if err := mgr.RemoveSubscription(billingSub.ID()); !errors.Is(err, job.ErrNotFound) {
+		t.Errorf("Expected %v, but got %v", job.ErrNotFound, err)
+	}
+
+	subs, err = mgr.ListSubscriptions()
+	if err != nil {
+		t.Fatalf("Failed to list subscriptions: %v", err)
+	}
+	if len(subs) != 1 || subs[0].ID() != allSub.ID() {
+		t.Errorf("Expected only subscription %v, but got %v", allSub, subs)
+	}
+}
+
+func ManagerStatsTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// A completed and a failed job instance count the executions.
+
+	executed, err := job.NewJob(
+		job.WithKind("executed"),
+		job.WithExecutor(func(fail bool) error {
+			if fail {
+				return errors.New("execution failed")
+			}
+			return nil
+		}),
+		job.WithFailOnErrorResult(),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+	for _, fail := range []bool{false, true} {
+		ji, err := mgr.ScheduleJob(executed, job.WithScheduleAfter(0), job.WithArguments(fail))
+		if err != nil {
+			t.Errorf("Failed to schedule job: %v", err)
+			return
+		}
+		if _, err := mgr.WaitInstance(ctx, ji.UUID()); (err != nil) != fail {
+			t.Errorf("Unexpected job instance result: %v", err)
+			return
+		}
+	}
+
+	// A delayed job instance waits in the queue.
+
+	delayed, err := job.NewJob(
+		job.WithKind("delayed"),
+		job.WithExecutor(func() {}),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+	scheduledAt := time.Now().Add(time.Hour)
+	if _, err := mgr.ScheduleJob(delayed, job.WithScheduleAt(scheduledAt)); err != nil {
+		t.Errorf("Failed to schedule job: %v", err)
+		return
+	}
+
+	stats, err := mgr.Stats()
+	if err != nil {
+		t.Errorf("Failed to get stats: %v", err)
+		return
+	}
+	if stats.Window() != job.DefaultStatsWindow {
+		t.Errorf("Expected stats window %s, got %s", job.DefaultStatsWindow, stats.Window())
+	}
+	if stats.Completed() != 1 || stats.Failed() != 1 || stats.SuccessRate() != 0.5 || stats.FailureRate() != 0.5 {
+		t.Errorf("Expected 1 completed and 1 failed executions, got %v", stats)
+	}
+	counts := stats.Counts()
+	if counts[job.JobCompleted] != 1 || counts[job.JobTerminated] != 1 || counts[job.JobScheduled] != 1 {
+		t.Errorf("Expected 1 completed, 1 terminated and 1 scheduled instances, got %v", counts)
+	}
+	if stats.QueueDepth() != 1 {
+		t.Errorf("Expected queue depth 1, got %d", stats.QueueDepth())
+	}
+	if oldest, ok := stats.OldestScheduledAt(); !ok || time.Second < oldest.Sub(scheduledAt).Abs() {
+		t.Errorf("Expected oldest scheduled time %v, got %v (%v)", scheduledAt, oldest, ok)
+	}
+	if stats.NumWorkers() != mgr.NumWorkers() || stats.NumBusyWorkers() != 0 || stats.WorkerUtilization() != 0 {
+		t.Errorf("Expected %d idle workers, got %d/%d", mgr.NumWorkers(), stats.NumBusyWorkers(), stats.NumWorkers())
+	}
+
+	kinds := stats.Kinds()
+	if len(kinds) != 2 || kinds[0].Kind() != "delayed" || kinds[1].Kind() != "executed" {
+		t.Errorf("Expected stats of delayed and executed kinds, got %v", kinds)
+		return
+	}
+	if kinds[0].QueueDepth() != 1 || kinds[0].Completed() != 0 {
+		t.Errorf("Expected a queued delayed instance, got %v", kinds[0].Map())
+	}
+	if kinds[1].QueueDepth() != 0 || kinds[1].Completed() != 1 || kinds[1].Failed() != 1 {
+		t.Errorf("Expected the executed instances, got %v", kinds[1].Map())
+	}
+
+	// The executions and instances which finished before the window are not counted, but the queued instances are.
+
+	time.Sleep(10 * time.Millisecond)
+	stats, err = mgr.Stats(job.WithStatsWindow(time.Millisecond))
+	if err != nil {
+		t.Errorf("Failed to get stats: %v", err)
+		return
+	}
+	if stats.Completed() != 0 || stats.Failed() != 0 || stats.SuccessRate() != 0 {
+		t.Errorf("Expected no executions within the window, got %v", stats)
+	}
+	counts = stats.Counts()
+	if counts[job.JobCompleted] != 0 || counts[job.JobTerminated] != 0 || counts[job.JobScheduled] != 1 {
+		t.Errorf("Expected only 1 scheduled instance within the window, got %v", counts)
+	}
+}
+
+// lookupPages looks up all pages of the query with the specified page size by the page tokens.
+func lookupPages[T any](t *testing.T, lookup func(job.Query) ([]T, error), size int, opts ...job.QueryOption) []T {
+	t.Helper()
+	results := []T{}
+	pageOpts := append(opts, job.WithQueryLimit(size))
+	for {
+		query := job.NewQuery(pageOpts...)
+		page, err := lookup(query)
+		if err != nil {
+			t.Fatalf("Failed to lookup the page: %v", err)
+		}
+		if size < len(page) {
+			t.Fatalf("Expected at most %d results, got %d", size, len(page))
+		}
+		results = append(results, page...)
+		token, ok := job.NextPageToken(query, page)
+		if !ok {
+			return results
+		}
+		pageOpts = append(opts, job.WithQueryLimit(size), job.WithQueryPageToken(token))
+	}
+}
+
+func ManagerPageTest(t *testing.T, mgr job.Manager) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	paged, err := job.NewJob(
+		job.WithKind("paged"),
+		job.WithExecutor(func(ji job.Instance) {
+			ji.Infof("executed")
+		}),
+	)
+	if err != nil {
+		t.Errorf("Failed to create job: %v", err)
+		return
+	}
+	for range 5 {
+		ji, err := mgr.ScheduleJob(paged, job.WithScheduleAfter(0))
+		if err != nil {
+			t.Errorf("Failed to schedule job: %v", err)
+			return
+		}
+		if _, err := mgr.WaitInstance(ctx, ji.UUID()); err != nil {
+			t.Errorf("Failed to wait job instance: %v", err)
+			return
+		}
+	}
+
+	kindOpt := job.WithQueryKind("paged")
+
+	// The pages of the history are the whole history in the order of the query.
+
+	history, err := mgr.LookupInstanceHistory(job.NewQuery(kindOpt))
+	if err != nil {
+		t.Errorf("Failed to lookup history: %v", err)
+		return
+	}
+	historyString := func(history []job.InstanceState) string {
+		s := ""
+		for _, state := range history {
+			s += fmt.Sprintf("%s:%s,", state.UUID(), state.State())
+		}
+		return s
+	}
+	lookupHistory := func(query job.Query) ([]job.InstanceState, error) {
+		return mgr.LookupInstanceHistory(query)
+	}
+	pagedHistory := lookupPages(t, lookupHistory, 3, kindOpt)
+	if historyString(pagedHistory) != historyString(history) {
+		t.Errorf("Expected the paged history %v, got %v", history, pagedHistory)
+	}
+	reversedHistory := slices.Clone(history)
+	slices.Reverse(reversedHistory)
+	pagedHistory = lookupPages(t, lookupHistory, 3, kindOpt, job.WithQueryOrder(job.SortDescending))
+	if historyString(pagedHistory) != historyString(reversedHistory) {
+		t.Errorf("Expected the descending history %v, got %v", reversedHistory, pagedHistory)
+	}
+	offsetHistory, err := mgr.LookupInstanceHistory(job.NewQuery(kindOpt, job.WithQueryOffset(2), job.WithQueryLimit(3)))
+	if err != nil {
+		t.Errorf("Failed to lookup history: %v", err)
+		return
+	}
+	if historyString(offsetHistory) != historyString(history[2:5]) {
+		t.Errorf("Expected the history %v, got %v", history[2:5], offsetHistory)
+	}
+
+	// The pages of the logs are the whole logs in the order of the query.
+
+	logs, err := mgr.LookupInstanceLogs(job.NewQuery(kindOpt))
+	if err != nil {
+		t.Errorf("Failed to lookup logs: %v", err)
+		return
+	}
+	if len(logs) < 5 {
+		t.Errorf("Expected at least 5 logs, got %d", len(logs))
+	}
+	pagedLogs := lookupPages(t, mgr.LookupInstanceLogs, 2, kindOpt)
+	if len(pagedLogs) != len(logs) {
+		t.Errorf("Expected %d paged logs, got %d", len(logs), len(pagedLogs))
+	}
+	for n := range min(len(logs), len(pagedLogs)) {
+		if pagedLogs[n].UUID() != logs[n].UUID() || pagedLogs[n].Message() != logs[n].Message() {
+			t.Errorf("Expected log %v, got %v", logs[n], pagedLogs[n])
+		}
+	}
+
+	// The pages of the instances are sorted by their creation times.
+
+	pagedInstances := lookupPages(t, mgr.LookupInstances, 2, kindOpt)
+	if len(pagedInstances) != 5 {
+		t.Errorf("Expected 5 paged instances, got %d", len(pagedInstances))
+		return
+	}
+	for n := 1; n < len(pagedInstances); n++ {
+		if pagedInstances[n].CreatedAt().Before(pagedInstances[n-1].CreatedAt()) {
+			t.Errorf("Expected the instances sorted by their creation times, got %v", pagedInstances)
+		}
+	}
+	lastInstances, err := mgr.LookupInstances(job.NewQuery(kindOpt, job.WithQueryOrder(job.SortDescending), job.WithQueryLimit(1)))
+	if err != nil || len(lastInstances) != 1 || lastInstances[0].UUID() != pagedInstances[4].UUID() {
+		t.Errorf("Expected the last instance %v, got %v (%v)", pagedInstances[4], lastInstances, err)
+	}
+
+	// The pages of the instances are filtered by their current states.
+
+	completedInstances, err := mgr.LookupInstances(job.NewQuery(kindOpt, job.WithQueryState(job.JobCompleted), job.WithQueryOffset(1), job.WithQueryLimit(2)))
+	if err != nil || len(completedInstances) != 2 {
+		t.Errorf("Expected 2 completed instances, got %v (%v)", completedInstances, err)
+		return
+	}
+	for n, completedInstance := range completedInstances {
+		if completedInstance.UUID() != pagedInstances[n+1].UUID() || completedInstance.State() != job.JobCompleted {
+			t.Errorf("Expected the completed instance %v, got %v", pagedInstances[n+1], completedInstance)
+		}
+	}
+}
+
+func TestAuditRecordPage(t *testing.T) {
+	ctx := context.Background()
+	stores := []job.Store{
+		job.NewLocalStore(),
+		store.NewKvStoreWith(memdb.NewStore()),
+	}
+	for _, store := range stores {
+		t.Run(store.Name(), func(t *testing.T) {
+			if err := store.Start(); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := store.Stop(); err != nil {
+					t.Error(err)
+				}
+			}()
+
+			// The audit records with the same timestamp are paged by their identifiers without skipping or repeating.
+			ts := time.Now()
+			for range 5 {
+				record := job.NewAuditRecord(
+					job.WithAuditRecordTimestamp(ts),
+					job.WithAuditRecordOperation(job.AuditClear),
+				)
+				if err := store.LogAuditRecord(ctx, record); err != nil {
+					t.Fatal(err)
+				}
+			}
+			lookup := func(query job.Query) ([]job.AuditRecord, error) {
+				return store.LookupAuditRecords(ctx, query)
+			}
+			for _, order := range []job.SortOrder{job.SortAscending, job.SortDescending} {
+				records := lookupPages(t, lookup, 2, job.WithQueryOrder(order))
+				ids := map[job.UUID]bool{}
+				for _, record := range records {
+					ids[record.ID()] = true
+				}
+				if len(records) != 5 || len(ids) != 5 {
+					t.Errorf("Expected 5 unique audit records in %s order, got %v", order, records)
+				}
+			}
+		})
+	}
+}
+
 func TestManager(t *testing.T) {
 	tests := []func(t *testing.T, mgr job.Manager){
 		ManagerJobScheduleTest,
 		ManagerJobCancelTest,
+		ManagerJobNamedArgumentsTest,
+		ManagerJobResultSetTest,
+		ManagerJobWaitTest,
+		ManagerJobAuditTest,
+		ManagerSubscriptionTest,
+		ManagerStatsTest,
+		ManagerPageTest,
 	}
 
 	for _, test := range tests {
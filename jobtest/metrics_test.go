@@ -0,0 +1,236 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricValue returns the value of the metric which has the specified labels, or the sample count if the metric is a histogram.
+func metricValue(t *testing.T, gatherer prometheus.Gatherer, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := gatherer.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			matched := 0
+			for _, label := range m.GetLabel() {
+				if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+					matched++
+				}
+			}
+			if matched != len(labels) {
+				continue
+			}
+			switch {
+			case m.GetCounter() != nil:
+				return m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				return m.GetGauge().GetValue()
+			case m.GetHistogram() != nil:
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return 0
+}
+
+// waitMetric waits until the condition of the metric value is satisfied.
+func waitMetric(t *testing.T, gatherer prometheus.Gatherer, name string, labels map[string]string, cond func(float64) bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond(metricValue(t, gatherer, name, labels)) {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected %s%v: %v", name, labels, metricValue(t, gatherer, name, labels))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	mgr, err := job.NewManager(
+		job.WithNumWorkers(1),
+		job.WithMetricsRegisterer(reg),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Retries, queue waits and store operations are counted.
+
+	flaky, err := job.NewJob(
+		job.WithKind("flaky"),
+		job.WithExecutor(func(ji job.Instance) error {
+			if ji.Attempts() == 1 {
+				return errors.New("first attempt failed")
+			}
+			return nil
+		}),
+		job.WithFailOnErrorResult(),
+		job.WithMaxRetries(2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flakyLabels := map[string]string{"kind": "flaky"}
+	storeLabels := map[string]string{"store": mgr.Store().Name(), "operation": "EnqueueInstance"}
+	retried := metricValue(t, reg, "go_job_retried_total", flakyLabels)
+	waited := metricValue(t, reg, "go_job_queue_wait_seconds", flakyLabels)
+	enqueued := metricValue(t, reg, "go_job_store_operation_duration_seconds", storeLabels)
+
+	ji, err := mgr.ScheduleJob(flaky)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgr.WaitInstance(ctx, ji.UUID()); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := metricValue(t, reg, "go_job_retried_total", flakyLabels); v != retried+1 {
+		t.Errorf("expected %v retries, got %v", retried+1, v)
+	}
+	if v := metricValue(t, reg, "go_job_queue_wait_seconds", flakyLabels); v != waited+2 {
+		t.Errorf("expected %v queue waits, got %v", waited+2, v)
+	}
+	if v := metricValue(t, reg, "go_job_store_operation_duration_seconds", storeLabels); v < enqueued+2 {
+		t.Errorf("expected at least %v enqueue operations, got %v", enqueued+2, v)
+	}
+
+	// Processing instances and the oldest waiting instance are reported while the worker is busy.
+
+	release := make(chan struct{})
+	blocking, err := job.NewJob(
+		job.WithKind("blocking"),
+		job.WithExecutor(func() {
+			<-release
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.RegisterJob(blocking); err != nil {
+		t.Fatal(err)
+	}
+
+	blockingLabels := map[string]string{"kind": "blocking"}
+	waitMetric(t, reg, "go_job_queue_oldest_age_seconds", blockingLabels, func(v float64) bool { return v == 0 })
+
+	instances := []job.Instance{}
+	for range 2 {
+		ji, err := mgr.ScheduleJob(blocking)
+		if err != nil {
+			t.Fatal(err)
+		}
+		instances = append(instances, ji)
+	}
+
+	waitMetric(t, reg, "go_job_processing", blockingLabels, func(v float64) bool { return v == 1 })
+	waitMetric(t, reg, "go_job_queue_oldest_age_seconds", blockingLabels, func(v float64) bool { return 0 < v })
+
+	close(release)
+	for _, ji := range instances {
+		if _, err := mgr.WaitInstance(ctx, ji.UUID()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	waitMetric(t, reg, "go_job_processing", blockingLabels, func(v float64) bool { return v == 0 })
+	waitMetric(t, reg, "go_job_queue_oldest_age_seconds", blockingLabels, func(v float64) bool { return v == 0 })
+}
+
+func TestMetricsRegisterer(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	newManager := func(opts ...any) job.Manager {
+		t.Helper()
+		mgr, err := job.NewManager(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.Start(); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { mgr.Stop() })
+		return mgr
+	}
+
+	// Managers registered with the same registerer are distinguished by the constant labels.
+
+	nodes := []string{"node1", "node2"}
+	for n, node := range nodes {
+		mgr := newManager(
+			job.WithNumWorkers(n+1),
+			job.WithMetricsRegisterer(reg),
+			job.WithMetricsConstLabels(map[string]string{"node": node, "cluster": "test"}),
+		)
+		if v := metricValue(t, reg, "go_job_workers", map[string]string{"node": node, "cluster": "test"}); v != float64(mgr.NumWorkers()) {
+			t.Errorf("expected %v workers on %s, got %v", mgr.NumWorkers(), node, v)
+		}
+	}
+
+	// Managers with the same constant labels conflict with each other.
+
+	_, err := job.NewManager(
+		job.WithMetricsRegisterer(reg),
+		job.WithMetricsConstLabels(map[string]string{"node": nodes[0], "cluster": "test"}),
+	)
+	if err == nil {
+		t.Error("expected a duplicate metrics registration error")
+	}
+
+	// The namespace is prefixed to the metric names.
+
+	mgr := newManager(
+		job.WithNumWorkers(1),
+		job.WithMetricsRegisterer(reg),
+		job.WithMetricsNamespace("custom"),
+	)
+	echo, err := job.NewJob(
+		job.WithKind("echo"),
+		job.WithExecutor(func() {}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.RegisterJob(echo); err != nil {
+		t.Fatal(err)
+	}
+	if v := metricValue(t, reg, "custom_registered", nil); v != 1 {
+		t.Errorf("expected 1 registered job, got %v", v)
+	}
+	if v := metricValue(t, reg, "go_job_registered", nil); v != 0 {
+		t.Errorf("expected no registered jobs without the namespace, got %v", v)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+type middlewareTraceKey struct{}
+
+func TestMiddleware(t *testing.T) {
+	var mutex sync.Mutex
+	calls := []string{}
+	record := func(name string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls = append(calls, name)
+	}
+
+	tracer := func(next job.ExecuteFunc) job.ExecuteFunc {
+		return func(ctx context.Context, ji job.Instance, args []any) ([]any, error) {
+			record("manager:before")
+			ctx = context.WithValue(ctx, middlewareTraceKey{}, "trace-"+ji.Kind())
+			res, err := next(ctx, ji, args)
+			record("manager:after")
+			return res, err
+		}
+	}
+
+	mgr, err := job.NewManager(
+		job.WithManagerMiddleware(tracer),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var elapsed time.Duration
+	timer := func(next job.ExecuteFunc) job.ExecuteFunc {
+		return func(ctx context.Context, ji job.Instance, args []any) ([]any, error) {
+			record("timer:before")
+			startedAt := time.Now()
+			res, err := next(ctx, ji, args)
+			elapsed = time.Since(startedAt)
+			record("timer:after")
+			return res, err
+		}
+	}
+	upper := func(next job.ExecuteFunc) job.ExecuteFunc {
+		return func(ctx context.Context, ji job.Instance, args []any) ([]any, error) {
+			record("upper:before")
+			if ji == nil {
+				t.Errorf("expected the job instance to be passed to the middleware")
+			}
+			upperArgs := make([]any, len(args))
+			for n, arg := range args {
+				upperArgs[n] = strings.ToUpper(arg.(string))
+			}
+			res, err := next(ctx, ji, upperArgs)
+			record("upper:after")
+			return res, err
+		}
+	}
+
+	j, err := job.NewJob(
+		job.WithKind("greet"),
+		job.WithExecutor(func(ctx context.Context, name string) string {
+			record("executor")
+			time.Sleep(10 * time.Millisecond)
+			trace, _ := ctx.Value(middlewareTraceKey{}).(string)
+			return trace + ":" + name
+		}),
+		job.WithMiddleware(timer, upper),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ji, err := mgr.ScheduleJob(j, job.WithArguments("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rs, err := mgr.WaitInstance(ctx, ji.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var greeting string
+	if err := rs.Scan(&greeting); err != nil || greeting != "trace-greet:ALICE" {
+		t.Errorf("expected trace-greet:ALICE, got %q (%v)", greeting, err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	expected := []string{
+		"manager:before",
+		"timer:before",
+		"upper:before",
+		"executor",
+		"upper:after",
+		"timer:after",
+		"manager:after",
+	}
+	if strings.Join(calls, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected middleware calls %v, got %v", expected, calls)
+	}
+	if elapsed < 10*time.Millisecond {
+		t.Errorf("expected the timer middleware to measure the executor, got %s", elapsed)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+func TestNotification(t *testing.T) {
+	var mutex sync.Mutex
+	deliveries := map[string][]map[string]any{}
+	failures := map[string]int{"/billing": 1}
+
+	secret := "s3cr3t"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if 0 < failures[r.URL.Path] {
+			failures[r.URL.Path]--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/billing" && !job.VerifyNotificationSignature(secret, body, r.Header.Get(job.NotificationSignatureHeader)) {
+			t.Errorf("invalid notification signature: %s", r.Header.Get(job.NotificationSignatureHeader))
+		}
+		var m map[string]any
+		if err := json.Unmarshal(body, &m); err != nil {
+			t.Errorf("invalid notification payload: %v", err)
+		}
+		if m["subscription"] != r.Header.Get(job.NotificationSubscriptionHeader) {
+			t.Errorf("expected subscription %s, got %v", r.Header.Get(job.NotificationSubscriptionHeader), m["subscription"])
+		}
+		deliveries[r.URL.Path] = append(deliveries[r.URL.Path], m)
+	}))
+	defer server.Close()
+
+	mgr, err := job.NewManager(
+		job.WithNotificationMaxAttempts(3),
+		job.WithNotificationBackoff(func(attempts int) time.Duration {
+			return 10 * time.Millisecond
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jobs := []struct {
+		kind     string
+		executor any
+	}{
+		{"billing.charge", func() error { return errors.New("card declined") }},
+		{"report", func() string { return "ok" }},
+	}
+	for _, j := range jobs {
+		jb, err := job.NewJob(job.WithKind(j.kind), job.WithExecutor(j.executor), job.WithFailOnErrorResult())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.RegisterJob(jb); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subs := []job.Subscription{}
+	for _, opts := range [][]job.SubscriptionOption{
+		{
+			job.WithSubscriptionURL(server.URL + "/billing"),
+			job.WithSubscriptionKind("billing.*"),
+			job.WithSubscriptionStates(job.JobTerminated),
+			job.WithSubscriptionSecret(secret),
+		},
+		{
+			job.WithSubscriptionURL(server.URL + "/completed"),
+			job.WithSubscriptionStates(job.JobCompleted),
+		},
+	} {
+		sub, err := job.NewSubscription(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mgr.AddSubscription(sub); err != nil {
+			t.Fatal(err)
+		}
+		subs = append(subs, sub)
+	}
+
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, j := range jobs {
+		ji, err := mgr.ScheduleRegisteredJob(j.kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mgr.WaitInstance(ctx, ji.UUID()) // nolint: errcheck
+	}
+
+	expected := map[string]struct {
+		sub   job.Subscription
+		kind  string
+		state job.JobState
+	}{
+		"/billing":   {subs[0], "billing.charge", job.JobTerminated},
+		"/completed": {subs[1], "report", job.JobCompleted},
+	}
+
+	for {
+		mutex.Lock()
+		n := len(deliveries)
+		mutex.Unlock()
+		if n == len(expected) || ctx.Err() != nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	// Wait a while to detect unexpected notifications.
+	time.Sleep(200 * time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if failures["/billing"] != 0 {
+		t.Errorf("expected the failed notification to be retried")
+	}
+	for path, e := range expected {
+		payloads := deliveries[path]
+		if len(payloads) != 1 {
+			t.Errorf("expected 1 notification to %s, got %d (%v)", path, len(payloads), payloads)
+			continue
+		}
+		m := payloads[0]
+		if m["kind"] != e.kind || m["state"] != e.state.String() || m["subscription"] != e.sub.ID().String() {
+			t.Errorf("unexpected notification to %s: %v", path, m)
+		}
+	}
+}
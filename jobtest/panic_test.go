@@ -0,0 +1,208 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func panickedJobsTotal(t *testing.T, gatherer prometheus.Gatherer, kind string) float64 {
+	t.Helper()
+	return metricValue(t, gatherer, "go_job_panicked_total", map[string]string{"kind": kind})
+}
+
+func TestPanicRecovery(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	mgr, err := job.NewManager(job.WithMetricsRegisterer(reg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// A panicking executor terminates its instance, and counts against retries.
+
+	panicky, err := job.NewJob(
+		job.WithKind("panicky"),
+		job.WithExecutor(func() {
+			var m map[string]int
+			m["boom"]++
+		}),
+		job.WithMaxRetries(2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	panicked := panickedJobsTotal(t, reg, "panicky")
+	ji, err := mgr.ScheduleJob(panicky)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = mgr.WaitInstance(ctx, ji.UUID())
+	if err == nil || !strings.Contains(err.Error(), job.ErrPanicked.Error()) || !strings.Contains(err.Error(), "assignment to entry in nil map") {
+		t.Errorf("expected %v, got %v", job.ErrPanicked, err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "goroutine") {
+		t.Errorf("expected the stack trace in the error, got %v", err)
+	}
+
+	history, err := mgr.LookupInstanceHistory(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	terminated := 0
+	for _, state := range history {
+		if state.State() == job.JobTerminated {
+			terminated++
+		}
+	}
+	if terminated != 2 {
+		t.Errorf("expected 2 terminated attempts, got %d (%v)", terminated, history)
+	}
+
+	logs, err := mgr.LookupInstanceLogs(job.NewQuery(job.WithQueryUUID(ji.UUID())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(logs, func(log job.Log) bool {
+		return log.Level() == job.LogError && strings.Contains(log.Message(), job.ErrPanicked.Error())
+	}) {
+		t.Errorf("expected the panic in the logs, got %v", logs)
+	}
+
+	if total := panickedJobsTotal(t, reg, "panicky") - panicked; total != 2 {
+		t.Errorf("expected 2 panicked executions, got %v", total)
+	}
+
+	// The workers keep processing other jobs after the panics.
+
+	sum, err := job.NewJob(
+		job.WithKind("sum"),
+		job.WithExecutor(func(a, b int) int { return a + b }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ji, err = mgr.ScheduleJob(sum, job.WithArguments(1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs, err := mgr.WaitInstance(ctx, ji.UUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res int
+	if err := rs.Scan(&res); err != nil || res != 3 {
+		t.Errorf("expected 3, got %v (%v)", res, err)
+	}
+}
+
+func TestHookPanicRecovery(t *testing.T) {
+	const kind = "hooked"
+	tests := []struct {
+		name string
+		opt  job.ManagerOption
+	}{
+		{
+			name: "after execute",
+			opt: job.WithAfterExecute(func(ctx context.Context, ji job.Instance, responses []any, err error) {
+				if ji.Kind() == kind {
+					panic("after execute hook")
+				}
+			}),
+		},
+		{
+			name: "state change",
+			opt: job.WithOnStateChange(func(ji job.Instance, state job.JobState) {
+				if ji.Kind() == kind && state == job.JobProcessing {
+					panic("state change hook")
+				}
+			}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			mgr, err := job.NewManager(job.WithMetricsRegisterer(reg), test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mgr.Start(); err != nil {
+				t.Fatal(err)
+			}
+			defer mgr.Stop()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// A panicking hook terminates the instance without stopping the worker.
+
+			hooked, err := job.NewJob(
+				job.WithKind(kind),
+				job.WithExecutor(func() {}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			panicked := panickedJobsTotal(t, reg, kind)
+			ji, err := mgr.ScheduleJob(hooked)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = mgr.WaitInstance(ctx, ji.UUID())
+			if err == nil || !strings.Contains(err.Error(), job.ErrPanicked.Error()) || !strings.Contains(err.Error(), "hook") {
+				t.Errorf("expected %v, got %v", job.ErrPanicked, err)
+			}
+			if total := panickedJobsTotal(t, reg, kind) - panicked; total != 1 {
+				t.Errorf("expected 1 panicked execution, got %v", total)
+			}
+
+			// The workers keep processing other jobs after the panic.
+
+			sum, err := job.NewJob(
+				job.WithKind("sum"),
+				job.WithExecutor(func(a, b int) int { return a + b }),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ji, err = mgr.ScheduleJob(sum, job.WithArguments(1, 2))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rs, err := mgr.WaitInstance(ctx, ji.UUID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var res int
+			if err := rs.Scan(&res); err != nil || res != 3 {
+				t.Errorf("expected 3, got %v (%v)", res, err)
+			}
+		})
+	}
+}
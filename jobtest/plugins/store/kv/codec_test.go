@@ -0,0 +1,199 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/encoding"
+	"github.com/cybergarage/go-job/job/plugins/store/kv"
+)
+
+func TestCodecs(t *testing.T) {
+	codecs := []kv.Codec{
+		kv.NewJSONCodec(),
+		kv.NewMessagePackCodec(),
+		kv.NewProtobufCodec(),
+	}
+
+	ji, err := job.NewInstance(
+		job.WithKind("codec"),
+		job.WithState(job.JobScheduled),
+		job.WithArguments(1, "a", 2.5),
+		job.WithNamedArguments(map[string]any{"b": "x"}),
+		job.WithPriority(10),
+		job.WithMaxRetries(3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := job.NewInstanceStateFromMap(map[string]any{
+		"kind":       ji.Kind(),
+		"uuid":       ji.UUID().String(),
+		"state":      job.JobCompleted.String(),
+		"result_set": "[3]",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log := job.NewLog(
+		job.WithLogKind(ji.Kind()),
+		job.WithLogUUID(ji.UUID()),
+		job.WithLogLevel(job.LogInfo),
+		job.WithLogMessage("hello"),
+	)
+
+	compressions := []kv.Compression{
+		kv.CompressionNone,
+		kv.CompressionGzip,
+		kv.CompressionZstd,
+	}
+
+	for _, codec := range codecs {
+		for _, compression := range compressions {
+			config := kv.NewConfig(
+				kv.WithCodec(codec),
+				kv.WithCompression(compression),
+			)
+			t.Run(codec.Format().String()+"/"+compression.String(), func(t *testing.T) {
+				testCodec(t, config, ji, state, log)
+			})
+		}
+	}
+}
+
+func testCodec(t *testing.T, config kv.Config, ji job.Instance, state job.InstanceState, log job.Log) {
+	t.Helper()
+
+	// Instance
+
+	obj, err := kv.NewObjectFromInstanceWith(config, ji)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedInstance, err := kv.NewInstanceFromBytes(obj.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decodedInstance.Equal(ji) {
+		t.Errorf("expected instance %v, got %v", ji, decodedInstance)
+	}
+	if decodedInstance.State() != ji.State() {
+		t.Errorf("expected state %s, got %s", ji.State(), decodedInstance.State())
+	}
+	if len(decodedInstance.Arguments()) != len(ji.Arguments()) {
+		t.Errorf("expected arguments %v, got %v", ji.Arguments(), decodedInstance.Arguments())
+	}
+	if len(decodedInstance.NamedArguments()) != len(ji.NamedArguments()) {
+		t.Errorf("expected named arguments %v, got %v", ji.NamedArguments(), decodedInstance.NamedArguments())
+	}
+	if !decodedInstance.Priority().Equal(ji.Priority()) {
+		t.Errorf("expected priority %v, got %v", ji.Priority(), decodedInstance.Priority())
+	}
+	if decodedInstance.MaxRetries() != ji.MaxRetries() {
+		t.Errorf("expected max retries %v, got %v", ji.MaxRetries(), decodedInstance.MaxRetries())
+	}
+
+	// Instance state
+
+	obj, err = kv.NewObjectFromInstanceStateWith(config, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedState, err := kv.NewInstanceStateFromBytes(obj.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decodedState.UUID() != state.UUID() || decodedState.State() != state.State() {
+		t.Errorf("expected state %v, got %v", state, decodedState)
+	}
+
+	// Log
+
+	obj, err = kv.NewObjectFromLogWith(config, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedLog, err := kv.NewLogFromBytes(obj.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decodedLog.UUID() != log.UUID() || decodedLog.Message() != log.Message() {
+		t.Errorf("expected log %v, got %v", log, decodedLog)
+	}
+}
+
+func TestCodecLegacyJSON(t *testing.T) {
+	ji, err := job.NewInstance(
+		job.WithKind("legacy"),
+		job.WithArguments(1, 2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Existing JSON data is stored without a format tag.
+
+	data, err := encoding.MapToJSON(ji.Map())
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedInstance, err := kv.NewInstanceFromBytes([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decodedInstance.Equal(ji) {
+		t.Errorf("expected instance %v, got %v", ji, decodedInstance)
+	}
+}
+
+func TestProtobufCodecTypes(t *testing.T) {
+	codec := kv.NewProtobufCodec()
+
+	m := map[string]any{
+		"int":    int64(-3),
+		"uint":   uint64(7),
+		"float":  2.5,
+		"bool":   true,
+		"string": "a",
+		"bytes":  []byte{0x00, 0x01},
+		"null":   nil,
+		"list":   []any{int64(1), "b", []any{false}},
+		"map":    map[string]any{"c": 1.5, "d": map[string]any{"e": int64(2)}},
+	}
+
+	b, err := codec.Encode(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := codec.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, m) {
+		t.Errorf("expected %#v, got %#v", m, decoded)
+	}
+
+	// Go values of unsupported types are not encoded.
+
+	if _, err := codec.Encode(map[string]any{"func": func() {}}); !errors.Is(err, kv.ErrInvalid) {
+		t.Errorf("expected %v, got %v", kv.ErrInvalid, err)
+	}
+}
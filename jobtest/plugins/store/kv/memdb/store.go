@@ -19,7 +19,7 @@ import (
 	"github.com/cybergarage/go-job/job/plugins/store/kv/memdb"
 )
 
-// NewStore creates a new etcd store for testing.
-func NewStore() kv.Store {
-	return memdb.NewStore()
+// NewStore creates a new memdb store for testing.
+func NewStore(opts ...kv.ConfigOption) kv.Store {
+	return memdb.NewStore(opts...)
 }
@@ -0,0 +1,107 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/store"
+	"github.com/cybergarage/go-job/job/plugins/store/kv"
+	"github.com/cybergarage/go-job/jobtest/plugins/store/kv/memdb"
+)
+
+func TestMaxPayloadSize(t *testing.T) {
+	const maxPayloadSize = 1024
+
+	ji, err := job.NewInstance(
+		job.WithKind("payload"),
+		job.WithArguments(strings.Repeat("a", maxPayloadSize*2)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The uncompressed payload exceeds the maximum size.
+
+	config := kv.NewConfig(
+		kv.WithMaxPayloadSize(maxPayloadSize),
+	)
+	_, err = kv.NewObjectFromInstanceWith(config, ji)
+	if !errors.Is(err, kv.ErrPayloadTooLarge) {
+		t.Errorf("expected %v, got %v", kv.ErrPayloadTooLarge, err)
+	}
+
+	// The compressed payload fits within the maximum size.
+
+	for _, compression := range []kv.Compression{kv.CompressionGzip, kv.CompressionZstd} {
+		config := kv.NewConfig(
+			kv.WithMaxPayloadSize(maxPayloadSize),
+			kv.WithCompression(compression),
+		)
+		obj, err := kv.NewObjectFromInstanceWith(config, ji)
+		if err != nil {
+			t.Errorf("%s: %v", compression, err)
+			continue
+		}
+		decodedInstance, err := kv.NewInstanceFromBytes(obj.Bytes())
+		if err != nil {
+			t.Errorf("%s: %v", compression, err)
+			continue
+		}
+		if decodedInstance.Arguments()[0] != ji.Arguments()[0] {
+			t.Errorf("%s: unexpected arguments %v", compression, decodedInstance.Arguments())
+		}
+	}
+}
+
+func TestMaxPayloadSizeOnSchedule(t *testing.T) {
+	const maxPayloadSize = 1024
+
+	mgr, err := job.NewManager(
+		job.WithStore(store.NewKvStoreWith(memdb.NewStore(kv.WithMaxPayloadSize(maxPayloadSize)))),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := mgr.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	j, err := job.NewJob(
+		job.WithKind("payload"),
+		job.WithExecutor(func(s string) {}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = mgr.ScheduleJob(j, job.WithArguments(strings.Repeat("a", maxPayloadSize*2)))
+	if !errors.Is(err, kv.ErrPayloadTooLarge) {
+		t.Errorf("expected %v, got %v", kv.ErrPayloadTooLarge, err)
+	}
+
+	_, err = mgr.ScheduleJob(j, job.WithArguments("a"))
+	if err != nil {
+		t.Error(err)
+	}
+}
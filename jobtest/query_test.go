@@ -15,6 +15,7 @@
 package jobtest
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -131,3 +132,63 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryPage(t *testing.T) {
+	query := job.NewQuery()
+	if _, ok := query.Limit(); ok {
+		t.Error("expected no limit")
+	}
+	if query.Offset() != 0 || query.Order() != job.SortAscending {
+		t.Errorf("expected no offset and the ascending order, got %d %s", query.Offset(), query.Order())
+	}
+	if _, ok := query.PageToken(); ok {
+		t.Error("expected no page token")
+	}
+
+	ji, err := job.NewInstance(job.WithKind("page"), job.WithCreatedAt(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := job.NewPageTokenFrom(ji)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsedToken, err := job.NewPageTokenFromString(token.String())
+	if err != nil || parsedToken != token {
+		t.Errorf("expected page token %s, got %s (%v)", token, parsedToken, err)
+	}
+	for _, s := range []string{"invalid", "bm90LWEtdG9rZW4"} {
+		if _, err := job.NewPageTokenFromString(s); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v for page token %q, got %v", job.ErrInvalid, s, err)
+		}
+	}
+
+	query = job.NewQuery(
+		job.WithQueryKind("page"),
+		job.WithQueryLimit(10),
+		job.WithQueryOffset(5),
+		job.WithQueryOrder(job.SortDescending),
+		job.WithQueryPageToken(token),
+	)
+	if limit, ok := query.Limit(); !ok || limit != 10 {
+		t.Errorf("expected limit 10, got %d", limit)
+	}
+	if query.Offset() != 5 || query.Order() != job.SortDescending {
+		t.Errorf("expected offset 5 and the descending order, got %d %s", query.Offset(), query.Order())
+	}
+	if queryToken, ok := query.PageToken(); !ok || queryToken != token {
+		t.Errorf("expected page token %s, got %s", token, queryToken)
+	}
+	if !query.Matches(ji) {
+		t.Error("expected the page not to affect the criteria")
+	}
+
+	for _, s := range []string{"asc", "DESC", "descending"} {
+		if _, err := job.NewSortOrderFromString(s); err != nil {
+			t.Errorf("expected sort order %q, got %v", s, err)
+		}
+	}
+	if _, err := job.NewSortOrderFromString("random"); !errors.Is(err, job.ErrInvalid) {
+		t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+	}
+}
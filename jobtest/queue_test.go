@@ -196,6 +196,8 @@ func TestInstanceQueue(t *testing.T) {
 	stores := []job.Store{
 		job.NewLocalStore(),
 		store.NewKvStoreWith(memdb.NewStore()),
+		store.NewKvStoreWith(memdb.NewStore(kv.WithCodec(kv.NewMessagePackCodec()))),
+		store.NewKvStoreWith(memdb.NewStore(kv.WithCodec(kv.NewProtobufCodec()))),
 		store.NewKvStoreWith(valkey.NewStore()),
 		store.NewKvStoreWith(etcd.NewStore()),
 		store.NewKvStoreWith(redis.NewStore()),
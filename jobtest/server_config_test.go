@@ -0,0 +1,313 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/cmd/server"
+	"github.com/cybergarage/go-job/job/plugins/job/system"
+)
+
+func TestServerConfig(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		conf := server.NewConfig()
+		if err := conf.Load(""); err != nil {
+			t.Fatal(err)
+		}
+		s, err := conf.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.GRPCPort() != job.DefaultGRPCPort || s.PrometheusPort() != job.DefaultPrometheusPort || s.HTTPPort() != job.DefaultHTTPPort {
+			t.Errorf("unexpected ports: %d %d %d", s.GRPCPort(), s.PrometheusPort(), s.HTTPPort())
+		}
+		if s.Manager().NumWorkers() != job.DefaultWorkerNum {
+			t.Errorf("expected %d workers, got %d", job.DefaultWorkerNum, s.Manager().NumWorkers())
+		}
+		if s.Manager().Store().Name() != "local" {
+			t.Errorf("expected local store, got %s", s.Manager().Store().Name())
+		}
+		if conf.DrainTimeout() != server.DefaultDrainTimeout {
+			t.Errorf("expected %s drain timeout, got %s", server.DefaultDrainTimeout, conf.DrainTimeout())
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "go-job.yaml")
+		yaml := `
+server:
+  bind_addr: 127.0.0.1
+  grpc_port: 59151
+  prometheus_port: 19190
+  http_port: 18180
+  drain_timeout: 30s
+worker:
+  num: 3
+store:
+  plugin: memdb
+jobs:
+  history_cleaner:
+    schedule: "0 0 * * *"
+    retention: 168h
+  audit_cleaner:
+    schedule: "0 1 * * *"
+`
+		if err := os.WriteFile(file, []byte(yaml), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		// Environment variables override the configuration file.
+
+		t.Setenv("GO_JOB_WORKER_NUM", "4")
+
+		conf := server.NewConfig()
+		if err := conf.Load(file); err != nil {
+			t.Fatal(err)
+		}
+		if conf.UsedConfigFile() != file {
+			t.Errorf("expected %s, got %s", file, conf.UsedConfigFile())
+		}
+		s, err := conf.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.BindAddr() != "127.0.0.1" || s.GRPCPort() != 59151 || s.PrometheusPort() != 19190 || s.HTTPPort() != 18180 {
+			t.Errorf("unexpected server config: %s %d %d %d", s.BindAddr(), s.GRPCPort(), s.PrometheusPort(), s.HTTPPort())
+		}
+		if s.Manager().NumWorkers() != 4 {
+			t.Errorf("expected 4 workers, got %d", s.Manager().NumWorkers())
+		}
+		if conf.DrainTimeout() != 30*time.Second {
+			t.Errorf("expected 30s drain timeout, got %s", conf.DrainTimeout())
+		}
+		if s.Manager().Store().Name() != "memdb" {
+			t.Errorf("expected memdb store, got %s", s.Manager().Store().Name())
+		}
+
+		// System jobs are scheduled only if their schedules are set.
+
+		if err := s.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := s.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+		if err := conf.ScheduleSystemJobs(s.Manager()); err != nil {
+			t.Fatal(err)
+		}
+		for kind, expected := range map[string]bool{
+			system.HistoryCleaner: true,
+			system.LogCleaner:     false,
+			system.AuditCleaner:   true,
+		} {
+			instances, err := s.Manager().LookupInstances(job.NewQuery(job.WithQueryKind(kind)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (0 < len(instances)) != expected {
+				t.Errorf("%s: expected scheduled %t, got %d instances", kind, expected, len(instances))
+			}
+		}
+
+		// System jobs are not scheduled again on reload.
+
+		for range 2 {
+			if err := conf.ReloadServer(s); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for kind, expected := range map[string]int{
+			system.HistoryCleaner: 1,
+			system.LogCleaner:     0,
+			system.AuditCleaner:   1,
+		} {
+			if n := numQueuedInstances(t, s.Manager(), kind); n != expected {
+				t.Errorf("%s: expected %d queued instances, got %d", kind, expected, n)
+			}
+		}
+	})
+
+	t.Run("commands", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "go-job.yaml")
+		yaml := `
+jobs:
+  commands:
+    - kind: greet
+      description: Greets the arguments
+      command: ["sh", "-c", "echo $GREETING {{index .Args 0}}"]
+      env: ["GREETING=hello"]
+      timeout: 10s
+      max_retries: 2
+    - kind: date
+      command: ["date"]
+      schedule: "0 0 * * *"
+`
+		if err := os.WriteFile(file, []byte(yaml), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		conf := server.NewConfig()
+		if err := conf.Load(file); err != nil {
+			t.Fatal(err)
+		}
+		s, err := conf.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := s.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+		if err := conf.RegisterJobs(s.Manager()); err != nil {
+			t.Fatal(err)
+		}
+
+		mgr := s.Manager()
+		greet, ok := mgr.LookupJob("greet")
+		if !ok {
+			t.Fatal("greet job is not registered")
+		}
+		if greet.Description() != "Greets the arguments" || greet.Policy().Timeout() != 10*time.Second || greet.Policy().MaxRetries() != 2 {
+			t.Errorf("unexpected job: %s %s %d", greet.Description(), greet.Policy().Timeout(), greet.Policy().MaxRetries())
+		}
+		ji, err := mgr.ScheduleJob(greet, job.WithArguments("go-job"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		rs, err := mgr.WaitInstance(ctx, ji.UUID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var output string
+		if err := rs.Scan(&output); err != nil || output != "hello go-job" {
+			t.Errorf("expected %q, got %v (%v)", "hello go-job", rs, err)
+		}
+
+		// Command jobs are scheduled only if their schedules are set.
+
+		instances, err := mgr.LookupInstances(job.NewQuery(job.WithQueryKind("date")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(instances) == 0 {
+			t.Error("expected scheduled instances, got none")
+		}
+
+		// Command jobs are registered again, but are not scheduled again on reload.
+
+		for range 2 {
+			if err := conf.ReloadServer(s); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, ok := mgr.LookupJob("greet"); !ok {
+			t.Error("greet job is not registered")
+		}
+		if n := numQueuedInstances(t, mgr, "date"); n != 1 {
+			t.Errorf("expected 1 queued instance, got %d", n)
+		}
+	})
+
+	t.Run("tracing", func(t *testing.T) {
+		conf := server.NewConfig()
+		if err := conf.Load(""); err != nil {
+			t.Fatal(err)
+		}
+		tp, err := conf.NewTracerProvider()
+		if err != nil || tp != nil {
+			t.Errorf("expected no tracer provider, got %v (%v)", tp, err)
+		}
+
+		t.Setenv("GO_JOB_TRACING_EXPORTER", server.TracingStdout)
+		tp, err = conf.NewTracerProvider()
+		if err != nil || tp == nil {
+			t.Fatalf("expected tracer provider, got %v (%v)", tp, err)
+		}
+		if err := tp.Shutdown(context.Background()); err != nil {
+			t.Error(err)
+		}
+
+		t.Setenv("GO_JOB_TRACING_EXPORTER", "unknown")
+		if _, err := conf.NewTracerProvider(); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("GO_JOB_STORE_PLUGIN", "unknown")
+		conf := server.NewConfig()
+		if err := conf.Load(""); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := conf.NewServer(); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+		}
+		if err := conf.Load(filepath.Join(t.TempDir(), "none.yaml")); err == nil {
+			t.Error("expected error for a missing configuration file")
+		}
+
+		file := filepath.Join(t.TempDir(), "go-job.yaml")
+		yaml := `
+jobs:
+  commands:
+    - kind: empty
+`
+		if err := os.WriteFile(file, []byte(yaml), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		conf = server.NewConfig()
+		if err := conf.Load(file); err != nil {
+			t.Fatal(err)
+		}
+		mgr, err := job.NewManager()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := conf.RegisterJobs(mgr); !errors.Is(err, job.ErrInvalid) {
+			t.Errorf("expected %v, got %v", job.ErrInvalid, err)
+		}
+	})
+}
+
+// numQueuedInstances returns the number of the queued job instances of the specified kind.
+func numQueuedInstances(t *testing.T, mgr job.Manager, kind string) int {
+	t.Helper()
+	instances, err := mgr.Store().ListInstances(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, ji := range instances {
+		if ji.Kind() == kind {
+			n++
+		}
+	}
+	return n
+}
@@ -16,11 +16,14 @@ package jobtest
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cybergarage/go-job/job"
 	"github.com/cybergarage/go-job/job/cmd/cli"
@@ -111,6 +114,27 @@ func ServerAPIsTest(t *testing.T, client job.Client, server job.Server) {
 
 	wg.Wait()
 
+	// Wait for the job instance result
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	waitedInstance, err := client.WaitInstance(ctx, instance.UUID())
+	if err != nil {
+		t.Fatalf("failed to wait job instance: %v", err)
+	}
+	if waitedInstance.State() != job.JobCompleted {
+		t.Errorf("expected job instance (%s:%s) to be completed, got %s", waitedInstance.Kind(), waitedInstance.UUID(), waitedInstance.State())
+	}
+	rs, err := waitedInstance.ResultSet()
+	if err != nil {
+		t.Errorf("failed to get job instance results: %v", err)
+	}
+	var sum int
+	if err := rs.Scan(&sum); err != nil || sum != 3 {
+		t.Errorf("expected job instance result 3, got %v (%v)", rs, err)
+	}
+
 	// Lookup job instance
 
 	instances, err := client.LookupInstances(
@@ -132,6 +156,113 @@ func ServerAPIsTest(t *testing.T, client job.Client, server job.Server) {
 	default:
 		t.Fatalf("expected exactly one job instance, got %d", len(instances))
 	}
+
+	// Lookup job instances by pages
+
+	pageQuery := job.NewQuery(job.WithQueryKind(kind), job.WithQueryLimit(1))
+	pageInstances, err := client.LookupInstances(pageQuery)
+	if err != nil {
+		t.Fatalf("failed to lookup job instances by pages: %v", err)
+	}
+	if len(pageInstances) != 1 {
+		t.Fatalf("expected exactly one job instance in the page, got %d", len(pageInstances))
+	}
+	token, ok := job.NextPageToken(pageQuery, pageInstances)
+	if !ok {
+		t.Fatal("expected the page token of the next page")
+	}
+	nextInstances, err := client.LookupInstances(job.NewQuery(job.WithQueryKind(kind), job.WithQueryLimit(1), job.WithQueryPageToken(token)))
+	if err != nil {
+		t.Fatalf("failed to lookup the next page: %v", err)
+	}
+	for _, ji := range nextInstances {
+		if ji.UUID() == pageInstances[0].UUID() {
+			t.Errorf("expected the next page without job instance %s", ji.UUID())
+		}
+	}
+
+	// Lookup audit records
+
+	records, err := client.LookupAuditRecords(job.NewQuery())
+	if err != nil {
+		t.Fatalf("failed to lookup audit records: %v", err)
+	}
+	found := false
+	for _, record := range records {
+		if record.Operation() == job.AuditScheduleJob && slices.Contains(record.UUIDs(), instance.UUID()) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("expected audit record of job instance %s, got %v", instance.UUID(), records)
+	}
+
+	// Get the queue statistics
+
+	stats, err := client.GetStats(job.WithStatsWindow(10 * time.Minute))
+	if err != nil {
+		t.Fatalf("failed to get stats: %v", err)
+	}
+	if stats.Window() != 10*time.Minute {
+		t.Errorf("expected stats window %s, got %s", 10*time.Minute, stats.Window())
+	}
+	if stats.Completed() < 1 || stats.Counts()[job.JobCompleted] < 1 {
+		t.Errorf("expected completed executions, got %v", stats)
+	}
+	if kinds := stats.Kinds(); len(kinds) != 1 || kinds[0].Kind() != kind || kinds[0].Completed() != stats.Completed() {
+		t.Errorf("expected stats of kind %s, got %v", kind, kinds)
+	}
+	if stats.NumWorkers() != server.Manager().NumWorkers() {
+		t.Errorf("expected %d workers, got %d", server.Manager().NumWorkers(), stats.NumWorkers())
+	}
+
+	// Add, list and remove a webhook subscription
+
+	sub, err := job.NewSubscription(
+		job.WithSubscriptionURL("https://example.com/hooks/sum"),
+		job.WithSubscriptionKind(kind),
+		job.WithSubscriptionStates(job.JobCompleted, job.JobTerminated),
+		job.WithSubscriptionSecret("s3cr3t"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create subscription: %v", err)
+	}
+	addedSub, err := client.AddSubscription(sub)
+	if err != nil {
+		t.Fatalf("failed to add subscription: %v", err)
+	}
+	if addedSub.URL() != sub.URL() || addedSub.Kind() != kind || len(addedSub.States()) != 2 {
+		t.Errorf("expected subscription %v, got %v", sub, addedSub)
+	}
+	if 0 < len(addedSub.Secret()) {
+		t.Errorf("expected subscription secret not to be returned")
+	}
+	subs, err := client.ListSubscriptions()
+	if err != nil {
+		t.Fatalf("failed to list subscriptions: %v", err)
+	}
+	if len(subs) != 1 || subs[0].ID() != addedSub.ID() || 0 < len(subs[0].Secret()) {
+		t.Errorf("expected subscription %v, got %v", addedSub, subs)
+	}
+	storedSubs, err := server.Manager().ListSubscriptions()
+	if err != nil {
+		t.Fatalf("failed to list subscriptions: %v", err)
+	}
+	if len(storedSubs) != 1 || storedSubs[0].Secret() != sub.Secret() {
+		t.Errorf("expected subscription secret to be stored")
+	}
+	err = client.RemoveSubscription(addedSub.ID())
+	if err != nil {
+		t.Fatalf("failed to remove subscription: %v", err)
+	}
+	subs, err = client.ListSubscriptions()
+	if err != nil {
+		t.Fatalf("failed to list subscriptions: %v", err)
+	}
+	if len(subs) != 0 {
+		t.Errorf("expected no subscriptions, got %v", subs)
+	}
 }
 
 func TestServerAPIs(t *testing.T) {
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+)
+
+type testCertFiles struct {
+	caFile         string
+	serverCertFile string
+	serverKeyFile  string
+	clientCertFile string
+	clientKeyFile  string
+}
+
+// newTestCertFiles generates a self-signed CA, and server and client certificates signed by the CA in the specified directory.
+func newTestCertFiles(t *testing.T, dir string) testCertFiles {
+	t.Helper()
+
+	writePEM := func(name string, typ string, der []byte) string {
+		file := filepath.Join(dir, name)
+		data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}) // nolint:exhaustruct
+		if err := os.WriteFile(file, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+
+	writeKey := func(name string, key *ecdsa.PrivateKey) string {
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return writePEM(name, "EC PRIVATE KEY", der)
+	}
+
+	now := time.Now()
+
+	caKey := newKey()
+	caTmpl := &x509.Certificate{ // nolint:exhaustruct
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "go-job test CA"}, // nolint:exhaustruct
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newCert := func(serial int64, name string, usage x509.ExtKeyUsage) ([]byte, *ecdsa.PrivateKey) {
+		key := newKey()
+		tmpl := &x509.Certificate{ // nolint:exhaustruct
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name}, // nolint:exhaustruct
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     now.Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der, key
+	}
+
+	serverDER, serverKey := newCert(2, "localhost", x509.ExtKeyUsageServerAuth)
+	clientDER, clientKey := newCert(3, "jobctl", x509.ExtKeyUsageClientAuth)
+
+	return testCertFiles{
+		caFile:         writePEM("ca.pem", "CERTIFICATE", caDER),
+		serverCertFile: writePEM("server.pem", "CERTIFICATE", serverDER),
+		serverKeyFile:  writeKey("server-key.pem", serverKey),
+		clientCertFile: writePEM("client.pem", "CERTIFICATE", clientDER),
+		clientKeyFile:  writeKey("client-key.pem", clientKey),
+	}
+}
+
+func TestServerTLS(t *testing.T) {
+	t.Setenv("PATH", fmt.Sprintf("%s/bin:%s", os.Getenv("GOPATH"),
+		os.Getenv("PATH")))
+
+	certs := newTestCertFiles(t, t.TempDir())
+
+	newServer := func(t *testing.T, clientAuth bool) job.Server {
+		t.Helper()
+		server, err := job.NewServer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		server.SetTLSEnabled(true)
+		server.SetTLSCertFile(certs.serverCertFile)
+		server.SetTLSKeyFile(certs.serverKeyFile)
+		server.SetTLSCAFile(certs.caFile)
+		server.SetTLSClientAuth(clientAuth)
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		return server
+	}
+
+	newTLSConfig := func(clientCert bool) job.TLSConfig {
+		config := job.NewTLSConfig()
+		config.SetTLSEnabled(true)
+		config.SetTLSCAFile(certs.caFile)
+		if clientCert {
+			config.SetTLSCertFile(certs.clientCertFile)
+			config.SetTLSKeyFile(certs.clientKeyFile)
+		}
+		return config
+	}
+
+	getVersion := func(client job.Client) error {
+		if err := client.Open(); err != nil {
+			return err
+		}
+		defer client.Close()
+		_, err := client.GetVersion()
+		return err
+	}
+
+	t.Run("tls", func(t *testing.T) {
+		server := newServer(t, false)
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		clients := []job.Client{
+			job.NewGrpcClient(),
+			job.NewCliClient(),
+		}
+		for _, client := range clients {
+			client.SetPort(server.GRPCPort())
+			client.SetTLSConfig(newTLSConfig(false))
+			t.Run(fmt.Sprintf("client(%s)", client.Name()), func(t *testing.T) {
+				ServerAPIsTest(t, client, server)
+			})
+		}
+
+		// A plaintext client can't connect to the TLS server.
+
+		client := job.NewGrpcClient()
+		client.SetPort(server.GRPCPort())
+		if err := getVersion(client); err == nil {
+			t.Errorf("expected a plaintext client to fail")
+		}
+	})
+
+	t.Run("mtls", func(t *testing.T) {
+		server := newServer(t, true)
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		clients := []job.Client{
+			job.NewGrpcClient(),
+			job.NewCliClient(),
+		}
+		for _, client := range clients {
+			client.SetPort(server.GRPCPort())
+			client.SetTLSConfig(newTLSConfig(true))
+			t.Run(fmt.Sprintf("client(%s)", client.Name()), func(t *testing.T) {
+				ServerAPIsTest(t, client, server)
+			})
+		}
+
+		// A client without a client certificate can't connect to the mTLS server.
+
+		client := job.NewGrpcClient()
+		client.SetPort(server.GRPCPort())
+		client.SetTLSConfig(newTLSConfig(false))
+		if err := getVersion(client); err == nil {
+			t.Errorf("expected a client without a certificate to fail")
+		}
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/store"
+	"github.com/cybergarage/go-job/jobtest/plugins/store/kv/memdb"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// waitSpans waits until the exporter has the spans of all the specified names, and returns the exported spans.
+func waitSpans(t *testing.T, exporter *tracetest.InMemoryExporter, names ...string) tracetest.SpanStubs {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		spans := exporter.GetSpans()
+		found := map[string]bool{}
+		for _, span := range spans {
+			found[span.Name] = true
+		}
+		missing := []string{}
+		for _, name := range names {
+			if !found[name] {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) == 0 {
+			return spans
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("spans %v are not exported: %v", missing, spans.Snapshots())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// findSpans returns the spans of the specified name which have the specified attribute value.
+func findSpans(spans tracetest.SpanStubs, name string, key string, value string) tracetest.SpanStubs {
+	found := tracetest.SpanStubs{}
+	for _, span := range spans {
+		if span.Name != name {
+			continue
+		}
+		for _, attr := range span.Attributes {
+			if string(attr.Key) == key && attr.Value.Emit() == value {
+				found = append(found, span)
+			}
+		}
+	}
+	return found
+}
+
+func TestTracing(t *testing.T) {
+	stores := []job.Store{
+		job.NewLocalStore(),
+		store.NewKvStoreWith(memdb.NewStore()),
+	}
+
+	for _, s := range stores {
+		t.Run(s.Name(), func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			mgr, err := job.NewManager(
+				job.WithStore(s),
+				job.WithTracerProvider(tp),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			j, err := job.NewJob(
+				job.WithKind("traced"),
+				job.WithExecutor(func(ctx context.Context) string {
+					return trace.SpanContextFromContext(ctx).TraceID().String()
+				}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mgr.Start(); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := mgr.Stop(); err != nil {
+					t.Error(err)
+				}
+			}()
+			if err := mgr.RegisterJob(j); err != nil {
+				t.Fatal(err)
+			}
+
+			// Schedule the job in the trace of the caller
+
+			ctx, parent := tp.Tracer("jobtest").Start(context.Background(), "request")
+			ji, err := mgr.ScheduleRegisteredJob("traced", ctx, job.WithScheduleAfter(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parent.End()
+
+			waitCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			rs, err := mgr.WaitInstance(waitCtx, ji.UUID())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The executor runs in the trace of the caller
+
+			traceID := parent.SpanContext().TraceID()
+			var execTraceID string
+			if err := rs.Scan(&execTraceID); err != nil {
+				t.Fatal(err)
+			}
+			if execTraceID != traceID.String() {
+				t.Errorf("expected trace %s in executor, got %s", traceID, execTraceID)
+			}
+
+			spans := waitSpans(t, exporter, job.TraceSpanEnqueue, job.TraceSpanQueueWait, job.TraceSpanExecute)
+			for _, span := range spans {
+				if span.SpanContext.TraceID() != traceID {
+					t.Errorf("span %s is not in trace %s", span.Name, traceID)
+				}
+			}
+
+			enqueueSpans := findSpans(spans, job.TraceSpanEnqueue, "job.uuid", ji.UUID().String())
+			if len(enqueueSpans) != 1 {
+				t.Fatalf("expected 1 enqueue span, got %d", len(enqueueSpans))
+			}
+			enqueue := enqueueSpans[0]
+			if enqueue.Parent.SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("enqueue span is not a child of the caller span")
+			}
+			for _, name := range []string{job.TraceSpanQueueWait, job.TraceSpanExecute} {
+				found := findSpans(spans, name, "job.uuid", ji.UUID().String())
+				if len(found) != 1 {
+					t.Fatalf("expected 1 %s span, got %d", name, len(found))
+				}
+				if found[0].Parent.SpanID() != enqueue.SpanContext.SpanID() {
+					t.Errorf("%s span is not a child of the enqueue span", name)
+				}
+			}
+
+			// Each state change is recorded as a span
+
+			for _, state := range []job.JobState{job.JobCreated, job.JobScheduled, job.JobProcessing} {
+				if found := findSpans(spans, job.TraceSpanStateChange, "job.state", state.String()); len(found) != 1 {
+					t.Errorf("expected 1 %s state span, got %d", state, len(found))
+				}
+			}
+			spans = waitSpans(t, exporter, job.TraceSpanStateChange)
+			deadline := time.Now().Add(5 * time.Second)
+			for len(findSpans(spans, job.TraceSpanStateChange, "job.state", job.JobCompleted.String())) == 0 {
+				if time.Now().After(deadline) {
+					t.Fatalf("%s state span is not exported", job.JobCompleted)
+				}
+				time.Sleep(10 * time.Millisecond)
+				spans = exporter.GetSpans()
+			}
+		})
+	}
+
+	t.Run("http", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+		server, err := job.NewServer(job.WithTracerProvider(tp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		j, err := job.NewJob(
+			job.WithKind("sum"),
+			job.WithExecutor(func(a, b int) int { return a + b }),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := server.Manager().RegisterJob(j); err != nil {
+			t.Fatal(err)
+		}
+		server.SetHTTPPort(newTestFreePort(t))
+		if err := server.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := server.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		// The trace context of the request is propagated to the job instance
+
+		traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID := "00f067aa0ba902b7"
+		req, err := http.NewRequest(http.MethodPost,
+			fmt.Sprintf("http://localhost:%d/v1/instances", server.HTTPPort()),
+			strings.NewReader(`{"kind":"sum","arguments":["1","2"]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("traceparent", "00-"+traceID+"-"+parentSpanID+"-01")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+
+		spans := waitSpans(t, exporter, job.TraceSpanSchedule, job.TraceSpanEnqueue, job.TraceSpanExecute)
+		for _, span := range spans {
+			if span.SpanContext.TraceID().String() != traceID {
+				t.Errorf("span %s is not in trace %s", span.Name, traceID)
+			}
+		}
+		for _, span := range spans {
+			if span.Name == job.TraceSpanSchedule && span.Parent.SpanID().String() != parentSpanID {
+				t.Errorf("schedule span is not a child of the request span")
+			}
+		}
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2025 The go-job Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cybergarage/go-job/job"
+	"github.com/cybergarage/go-job/job/plugins/job/webhook"
+)
+
+func TestWebhookJob(t *testing.T) {
+	var failures atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, r.Method+" "+r.Header.Get("X-Token")+" "+r.Header.Get("X-Request")+" "+string(body)) // nolint: errcheck
+	})
+	mux.HandleFunc("/fail", func(w http.ResponseWriter, r *http.Request) {
+		failures.Add(1)
+		http.Error(w, "failed", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mgr, err := job.NewManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := mgr.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	webhookJob, err := webhook.NewWebhookJob("webhook",
+		webhook.WithClient(server.Client()),
+		webhook.WithHeader("X-Token", "secret"),
+		job.WithMaxRetries(2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wait := func(t *testing.T, opts ...any) (job.ResultSet, error) {
+		t.Helper()
+		ji, err := mgr.ScheduleJob(webhookJob, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return mgr.WaitInstance(ctx, ji.UUID())
+	}
+
+	tests := []struct {
+		name     string
+		opts     []any
+		expected string
+	}{
+		{
+			name: "map",
+			opts: []any{job.WithArguments(map[string]any{
+				"url":    server.URL + "/echo",
+				"header": map[string]any{"X-Request": "map"},
+				"body":   "hello",
+			})},
+			expected: "POST secret map hello",
+		},
+		{
+			name:     "json",
+			opts:     []any{job.WithArguments(`{"method": "put", "url": "` + server.URL + `/echo", "body": "hello"}`)},
+			expected: "PUT secret  hello",
+		},
+		{
+			name: "named arguments",
+			opts: []any{job.WithNamedArguments(map[string]any{
+				"url": server.URL + "/echo",
+			})},
+			expected: "GET secret  ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := wait(t, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var status int
+			var body string
+			if err := rs.Scan(&status, &body); err != nil {
+				t.Fatal(err)
+			}
+			if status != http.StatusCreated || body != tt.expected {
+				t.Errorf("expected (%d, %q), got (%d, %q)", http.StatusCreated, tt.expected, status, body)
+			}
+		})
+	}
+
+	// Non-2xx status is an error, and the instance is retried by the retry policy.
+
+	t.Run("status", func(t *testing.T) {
+		_, err := wait(t, job.WithArguments(map[string]any{"url": server.URL + "/fail"}))
+		if err == nil || !strings.Contains(err.Error(), webhook.ErrUnexpectedStatus.Error()) {
+			t.Errorf("expected %v, got %v", webhook.ErrUnexpectedStatus, err)
+		}
+		if failures.Load() != 2 {
+			t.Errorf("expected 2 requests, got %d", failures.Load())
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := wait(t,
+			job.WithArguments(map[string]any{"url": server.URL + "/slow"}),
+			job.WithTimeout(100*time.Millisecond),
+			job.WithMaxRetries(0),
+		)
+		if !errors.Is(err, job.ErrTimedOut) {
+			t.Errorf("expected %v, got %v", job.ErrTimedOut, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := wait(t, job.WithArguments(map[string]any{"body": "no url"}), job.WithMaxRetries(0))
+		if err == nil {
+			t.Error("expected error for a request without url")
+		}
+	})
+}